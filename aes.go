@@ -5,12 +5,13 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 )
+
 //PKCS5#1
 func PKCS5Padding(ciphertext []byte, blockSize int) []byte {
-	padding := blockSize - len(ciphertext)%blockSize//需要padding的数目
+	padding := blockSize - len(ciphertext)%blockSize //需要padding的数目
 	//只要少于256就能放到一个byte中，默认的blockSize=16(即采用16*8=128, AES-128长的密钥)
 	//最少填充1个byte，如果原文刚好是blocksize的整数倍，则再填充一个blocksize
-	padtext := bytes.Repeat([]byte{byte(padding)}, padding)//生成填充的文本
+	padtext := bytes.Repeat([]byte{byte(padding)}, padding) //生成填充的文本
 	return append(ciphertext, padtext...)
 }
 
@@ -22,7 +23,7 @@ func PKCS5UnPadding(origData []byte) []byte {
 
 func ZeroPadding(ciphertext []byte, blockSize int) []byte {
 	padding := blockSize - len(ciphertext)%blockSize
-	padtext := bytes.Repeat([]byte{0}, padding)//用0去填充
+	padtext := bytes.Repeat([]byte{0}, padding) //用0去填充
 	return append(ciphertext, padtext...)
 }
 
@@ -32,8 +33,9 @@ func ZeroUnPadding(origData []byte) []byte {
 			return r == rune(0)
 		})
 }
+
 //aes加密
-func AesEncrypt(origData, key,iv []byte) ([]byte, error) {
+func AesEncrypt(origData, key, iv []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
@@ -48,17 +50,26 @@ func AesEncrypt(origData, key,iv []byte) ([]byte, error) {
 	blockMode.CryptBlocks(crypted, origData)
 	return crypted, nil
 }
+
 //aes解密
-func AesDecrypt(crypted, key,iv []byte) ([]byte, error) {
+func AesDecrypt(crypted, key, iv []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
 	}
+	blockSize := block.BlockSize()
+	//密文长度或填充不合法时(例如密码错误)返回错误而不是panic
+	if len(crypted) == 0 || len(crypted)%blockSize != 0 || len(iv) != blockSize {
+		return nil, ErrorAES
+	}
 	blockMode := cipher.NewCBCDecrypter(block, iv)
 	origData := make([]byte, len(crypted))
 	// origData := crypted
 	blockMode.CryptBlocks(origData, crypted)
+	if unpadding := int(origData[len(origData)-1]); unpadding == 0 || unpadding > blockSize {
+		return nil, ErrorAES
+	}
 	origData = PKCS5UnPadding(origData)
 	// origData = ZeroUnPadding(origData)
 	return origData, nil
-}
\ No newline at end of file
+}
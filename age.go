@@ -0,0 +1,131 @@
+package zzdm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+)
+
+const (
+	//age文件的扩展名
+	AgeExtension = ".age"
+	ageMagic     = "age-encryption.org/v1"
+)
+
+//开头是否为age文件或age文本格式
+func isAge(prefix []byte) bool {
+	return bytes.HasPrefix(prefix, []byte(ageMagic)) || bytes.HasPrefix(bytes.TrimLeft(prefix, " \t\r\n"), []byte(armor.Header))
+}
+
+//age的接收者:指定了公钥时使用公钥,否则使用口令
+func (o *Options) ageRecipients(password string) ([]age.Recipient, error) {
+	if len(o.Recipients) > 0 {
+		recipients, err := age.ParseRecipients(strings.NewReader(strings.Join(o.Recipients, "\n")))
+		if err != nil {
+			return nil, err
+		}
+		return recipients, nil
+	}
+	if len(password) == 0 {
+		return nil, ErrorPassword
+	}
+	recipient, err := age.NewScryptRecipient(password)
+	if err != nil {
+		return nil, err
+	}
+	return []age.Recipient{recipient}, nil
+}
+
+//age的身份:身份文件中的私钥,以及有口令时的scrypt身份
+func (o *Options) ageIdentities(password string) ([]age.Identity, error) {
+	identities := make([]age.Identity, 0)
+	for _, path := range o.Identities {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := age.ParseIdentities(file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, parsed...)
+	}
+	if len(password) > 0 {
+		identity, err := age.NewScryptIdentity(password)
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, identity)
+	}
+	if len(identities) == 0 {
+		return nil, ErrorPassword
+	}
+	return identities, nil
+}
+
+//以age格式加密数据流,Armor时输出age的文本格式
+func (e *Encryptor) encryptAgeStream(ctx context.Context, reader io.Reader, writer io.Writer, password string) error {
+	o := &e.options
+	recipients, err := o.ageRecipients(password)
+	if err != nil {
+		return err
+	}
+	var armored io.WriteCloser
+	if o.Armor {
+		armored = armor.NewWriter(writer)
+		writer = armored
+	}
+	encrypted, err := age.Encrypt(writer, recipients...)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(encrypted, &contextReader{ctx, reader})
+	if err != nil {
+		return err
+	}
+	err = encrypted.Close()
+	if err != nil {
+		return err
+	}
+	if armored != nil {
+		return armored.Close()
+	}
+	return nil
+}
+
+//解密age格式的数据流,自动识别age的文本格式
+func (d *Decryptor) decryptAgeStream(ctx context.Context, reader io.Reader, writer io.Writer, password string) error {
+	identities, err := d.options.ageIdentities(password)
+	if err != nil {
+		return err
+	}
+	buffered := bufio.NewReader(reader)
+	prefix, _ := buffered.Peek(len(ageMagic))
+	reader = buffered
+	if !bytes.HasPrefix(prefix, []byte(ageMagic)) {
+		reader = armor.NewReader(buffered)
+	}
+	decrypted, err := age.Decrypt(reader, identities...)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(writer, &contextReader{ctx, decrypted})
+	return err
+}
+
+//以age格式加密文件,输出文件名为原文件名加上.age
+func (e *Encryptor) encryptAge(ctx context.Context, input, output, password string) error {
+	return e.encryptFormat(ctx, input, output, password, AgeExtension, e.encryptAgeStream)
+}
+
+//解密age格式的文件,输出文件名为去掉.age的原文件名
+func (d *Decryptor) decryptAge(ctx context.Context, file *os.File, input, output, password string) error {
+	return d.decryptFormat(ctx, file, input, output, password, AgeExtension, d.decryptAgeStream)
+}
@@ -0,0 +1,105 @@
+package zzdm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+)
+
+//testdata/age中是age测试套件(c2sp.org/CCTV/age)里X25519与scrypt的向量
+//每个文件开头是若干行"键: 值",空行之后是密文
+type ageVector struct {
+	expect     string
+	payload    string
+	passphrase string
+	identities []string
+	body       []byte
+}
+
+func readAgeVector(t *testing.T, path string) *ageVector {
+	t.Helper()
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vector := &ageVector{}
+	reader := bufio.NewReader(bytes.NewReader(data))
+	read := 0
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		read += len(line)
+		line = strings.TrimSuffix(line, "\n")
+		if len(line) == 0 {
+			break
+		}
+		pair := strings.SplitN(line, ": ", 2)
+		if len(pair) != 2 {
+			t.Fatalf("%s: bad line %q", path, line)
+		}
+		switch pair[0] {
+		case "expect":
+			vector.expect = pair[1]
+		case "payload":
+			vector.payload = pair[1]
+		case "passphrase":
+			vector.passphrase = pair[1]
+		case "identity":
+			vector.identities = append(vector.identities, pair[1])
+		}
+	}
+	vector.body = data[read:]
+	return vector
+}
+
+func TestAgeVectors(t *testing.T) {
+	paths, _ := filepath.Glob("testdata/age/*")
+	if len(paths) == 0 {
+		t.Fatal("no age vectors")
+	}
+	for _, path := range paths {
+		name := filepath.Base(path)
+		vector := readAgeVector(t, path)
+		var options []Option
+		if len(vector.identities) > 0 {
+			identity := filepath.Join(t.TempDir(), "identity.txt")
+			ioutil.WriteFile(identity, []byte(strings.Join(vector.identities, "\n")+"\n"), 0600)
+			options = append(options, WithIdentities(identity))
+		}
+		var output bytes.Buffer
+		err := NewDecryptor(options...).DecryptStream(context.Background(), bytes.NewReader(vector.body), &output, vector.passphrase)
+		var unmatched *age.NoIdentityMatchError
+		switch vector.expect {
+		case "success":
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+				continue
+			}
+			sum := sha256.Sum256(output.Bytes())
+			if hex.EncodeToString(sum[:]) != vector.payload {
+				t.Errorf("%s: payload mismatch", name)
+			}
+		case "no match":
+			if !errors.As(err, &unmatched) {
+				t.Errorf("%s: got %v, want no match", name, err)
+			}
+		case "header failure":
+			if err == nil || errors.As(err, &unmatched) {
+				t.Errorf("%s: got %v, want header failure", name, err)
+			}
+		default:
+			t.Fatalf("%s: unknown expectation %q", name, vector.expect)
+		}
+	}
+}
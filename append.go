@@ -0,0 +1,400 @@
+package zzdm
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+//向已有的加密文件追加数据,使用文件原有的密钥与填充策略,已有的内容只需要解密末尾不满的帧
+//与续传相同,原文件截断到需要重写的帧之前后就地追加,截去的部分保存在同目录下的临时文件中,
+//出错时调用Abort写回,原文件恢复原样;只有文件头长度改变时才复制整个文件后替换原文件
+//原有的签名在追加时去掉,需要之后调用Sign重新签名
+type AppendWriter struct {
+	options *Options
+	ctx     context.Context
+	path    string
+	//原文件,新的帧写在截断的位置之后
+	file *os.File
+	//截断的位置,截去的内容与原文件头
+	cut        int64
+	saved      *os.File
+	headerData []byte
+	//文件头长度改变时写入的新文件,Close时替换原文件
+	replace *os.File
+	header  *Header
+	keys    *fileKeys
+	meta    *Metadata
+	//原文件头的长度
+	headerLength int64
+	//下一帧的序号
+	index  int64
+	buffer []byte
+	group  *parityGroup
+	closed bool
+}
+
+//打开加密文件并校验末尾,保存需要重写的部分后截断,准备追加
+func (e *Encryptor) NewAppendWriter(ctx context.Context, path, password string) (*AppendWriter, error) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	w, cut, err := newAppendWriter(ctx, &e.options, file, password)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	w.path = path
+	w.file = file
+	w.cut = cut
+	w.headerData = make([]byte, w.headerLength)
+	_, err = file.ReadAt(w.headerData, 0)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	saved, err := ioutil.TempFile(filepath.Dir(path), ".zzdm-")
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	w.saved = saved
+	info, err := file.Stat()
+	if err == nil {
+		_, err = io.Copy(saved, io.NewSectionReader(file, cut, info.Size()-cut))
+	}
+	if err == nil {
+		err = file.Truncate(cut)
+	}
+	if err == nil {
+		_, err = file.Seek(cut, io.SeekStart)
+	}
+	if err != nil {
+		w.Abort()
+		return nil, err
+	}
+	return w, nil
+}
+
+//校验文件末尾并读出需要重写的明文,返回保留部分的长度
+func newAppendWriter(ctx context.Context, o *Options, file *os.File, password string) (*AppendWriter, int64, error) {
+	header, err := ReadHead(file)
+	if err != nil {
+		return nil, 0, err
+	}
+	if header == nil {
+		return nil, 0, ErrorFileIO
+	}
+	keys, err := o.headerKeys(header, password)
+	if err != nil {
+		return nil, 0, err
+	}
+	meta, err := openMetadata(header, keys.data)
+	if err != nil {
+		return nil, 0, err
+	}
+	//旧格式的帧没有认证码,文件头中也没有明文长度
+	if keys.mac == nil || meta == nil {
+		return nil, 0, ErrorAppend
+	}
+	walker, err := newRecordWalker(file, header)
+	if err != nil {
+		return nil, 0, err
+	}
+	w := &AppendWriter{
+		options:      o,
+		ctx:          ctx,
+		header:       header,
+		keys:         keys,
+		meta:         meta,
+		headerLength: walker.offset,
+	}
+	//从包含明文末尾的帧所在的分组开始重写,其后的帧只有填充
+	frameSize := header.FrameSize
+	start := meta.Length / frameSize
+	if header.ParityData > 0 {
+		start -= start % int64(header.ParityData)
+	}
+	cut := walker.offset
+	tail := make([]int64, 0)
+	var last int64 = -1
+	for {
+		offset, parity, err := walker.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if parity {
+			continue
+		}
+		index := walker.frames - 1
+		if index == start {
+			cut = offset
+		}
+		if index >= start && index*frameSize < meta.Length {
+			tail = append(tail, offset)
+		}
+		last = offset
+	}
+	//签名记录在截断时一并去掉,追加后签名不再有效
+	if walker.frames != header.Frames || (walker.offset != walker.size && !walker.trailer) {
+		return nil, 0, ErrorFrameMissing
+	}
+	//最后一帧即使只有填充也需要通过认证
+	if last >= 0 {
+		_, err = walker.open(last, header.Frames-1, keys)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	for i, offset := range tail {
+		plain, err := walker.open(offset, start+int64(i), keys)
+		if err != nil {
+			return nil, 0, err
+		}
+		w.buffer = append(w.buffer, plain...)
+	}
+	if int64(len(w.buffer)) > meta.Length-start*frameSize {
+		w.buffer = w.buffer[:meta.Length-start*frameSize]
+	}
+	if start >= header.Frames {
+		cut = walker.offset
+	}
+	w.index = start
+	if header.ParityData > 0 {
+		w.group = &parityGroup{keys: keys, shards: int(header.ParityShards), index: start / int64(header.ParityData)}
+	}
+	w.meta.Length = start*frameSize + int64(len(w.buffer))
+	return w, cut, nil
+}
+
+func (w *AppendWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, ErrorFileIO
+	}
+	if err := w.ctx.Err(); err != nil {
+		return 0, err
+	}
+	w.buffer = append(w.buffer, p...)
+	w.meta.Length += int64(len(p))
+	err := w.flush(false)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+//加密缓冲中完整的帧,final时连同不满的最后一帧一起写入
+func (w *AppendWriter) flush(final bool) error {
+	frameSize := int(w.header.FrameSize)
+	concurrency := w.options.Concurrency
+	for len(w.buffer) >= frameSize*concurrency || (final && len(w.buffer) > 0) {
+		batch := make([]*frameTask, 0, concurrency)
+		for len(batch) < concurrency && len(w.buffer) > 0 {
+			size := frameSize
+			if size > len(w.buffer) {
+				if !final {
+					break
+				}
+				size = len(w.buffer)
+			}
+			plain := make([]byte, size)
+			copy(plain, w.buffer)
+			w.buffer = w.buffer[size:]
+			batch = append(batch, &frameTask{index: w.index + int64(len(batch)), plain: plain})
+		}
+		parallel(len(batch), func(i int) {
+			batch[i].seal(w.keys)
+		})
+		for _, task := range batch {
+			if task.err != nil {
+				return task.err
+			}
+			record, err := frameRecord(&Frame{Iv: task.iv, Data: task.data, Hash: task.checksum, Mac: task.mac})
+			if err != nil {
+				return err
+			}
+			_, err = w.file.Write(record)
+			if err != nil {
+				return err
+			}
+			w.index++
+			if w.group != nil {
+				w.group.add(record)
+				if len(w.group.records) == int(w.header.ParityData) {
+					err = w.group.flush(w.file)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+//写入最后的帧与填充并更新文件头,文件头长度改变时替换原文件
+func (w *AppendWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	err := w.finish()
+	if err != nil {
+		w.Abort()
+		return err
+	}
+	w.closed = true
+	defer w.removeSaved()
+	err = w.file.Close()
+	if w.replace == nil {
+		return err
+	}
+	if err == nil {
+		err = w.replace.Close()
+	}
+	if err != nil {
+		w.replace.Close()
+		os.Remove(w.replace.Name())
+		return err
+	}
+	return os.Rename(w.replace.Name(), w.path)
+}
+
+//放弃追加,写回截去的内容与原文件头,原文件恢复原样
+func (w *AppendWriter) Abort() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	defer w.removeSaved()
+	if w.replace != nil {
+		w.replace.Close()
+		os.Remove(w.replace.Name())
+	}
+	err := w.file.Truncate(w.cut)
+	if err == nil {
+		_, err = w.file.Seek(w.cut, io.SeekStart)
+	}
+	if err == nil {
+		_, err = w.saved.Seek(0, io.SeekStart)
+	}
+	if err == nil {
+		_, err = io.Copy(w.file, w.saved)
+	}
+	if err == nil {
+		_, err = w.file.WriteAt(w.headerData, 0)
+	}
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (w *AppendWriter) removeSaved() {
+	if w.saved != nil {
+		w.saved.Close()
+		os.Remove(w.saved.Name())
+	}
+}
+
+func (w *AppendWriter) finish() error {
+	//没有记录填充策略的文件按当前的选项填充,并记录下来
+	if len(w.meta.PaddingPolicy) == 0 {
+		w.meta.PaddingPolicy, w.meta.PaddingBucket = w.options.Padding, w.options.PaddingBucket
+	}
+	padded, err := paddedLength(w.meta.Length, w.meta.PaddingPolicy, w.meta.PaddingBucket)
+	if err != nil {
+		return err
+	}
+	w.buffer = append(w.buffer, make([]byte, padded-w.meta.Length)...)
+	w.meta.Padding = padded - w.meta.Length
+	err = w.flush(true)
+	if err != nil {
+		return err
+	}
+	if w.group != nil {
+		err = w.group.flush(w.file)
+		if err != nil {
+			return err
+		}
+	}
+	header := w.header
+	header.Frames = w.index
+	header.Meta, err = sealMetadata(w.meta, w.keys.data)
+	if err != nil {
+		return err
+	}
+	header.Mac, err = w.keys.headerMac(header)
+	if err != nil {
+		return err
+	}
+	return w.updateHeader()
+}
+
+//文件头长度不变时直接覆盖,否则连同帧一起复制到临时文件,帧的内容不需要解密
+func (w *AppendWriter) updateHeader() error {
+	message, err := w.header.Marshal()
+	if err != nil {
+		return err
+	}
+	if int64(16+len(message)) == w.headerLength {
+		_, err = w.file.Seek(0, io.SeekStart)
+		if err != nil {
+			return err
+		}
+		return writeHeader(w.file, w.header)
+	}
+	temp, err := ioutil.TempFile(filepath.Dir(w.path), ".zzdm-")
+	if err != nil {
+		return err
+	}
+	w.replace = temp
+	writer := bufio.NewWriter(temp)
+	err = writeHeader(writer, w.header)
+	if err == nil {
+		_, err = w.file.Seek(w.headerLength, io.SeekStart)
+	}
+	if err == nil {
+		_, err = io.Copy(writer, w.file)
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		return err
+	}
+	if info, err := w.file.Stat(); err == nil {
+		temp.Chmod(info.Mode())
+	}
+	return nil
+}
+
+//将input的内容追加到加密文件path
+func (e *Encryptor) Append(ctx context.Context, input, path, password string) error {
+	raw, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer raw.Close()
+	writer, err := e.NewAppendWriter(ctx, path, password)
+	if err != nil {
+		return err
+	}
+	e.options.Logger.Printf("append %s -> %s", input, path)
+	_, err = io.Copy(writer, raw)
+	if err != nil {
+		writer.Abort()
+		return err
+	}
+	err = writer.Close()
+	if err != nil || e.options.SigningKey == nil {
+		return err
+	}
+	return e.Sign(ctx, path, password)
+}
@@ -0,0 +1,91 @@
+package zzdm
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAppend(t *testing.T) {
+	dir := t.TempDir()
+	plain := testPlain(10000)
+	archive := filepath.Join(dir, "plain.scc")
+	for _, parity := range []int{0, 2} {
+		options := []Option{WithFrameSize(1024), WithPadding(PaddingBucket, 3000), WithParity(parity, 1)}
+		ioutil.WriteFile(archive, encryptBytes(t, plain[:2500], options...), 0644)
+		more := filepath.Join(dir, "more.bin")
+		ioutil.WriteFile(more, plain[2500:], 0644)
+		//追加时的选项不影响文件原有的填充策略
+		err := NewEncryptor(WithPadding(PaddingNone, 0)).Append(context.Background(), more, archive, "password")
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := ioutil.ReadFile(archive)
+		if !bytes.Equal(decryptBytes(t, data), plain) {
+			t.Fatalf("parity %d: plaintext mismatch", parity)
+		}
+		header, _ := readRecords(t, data)
+		keys, _ := NewDecryptor().options.headerKeys(header, "password")
+		meta, _ := openMetadata(header, keys.data)
+		if meta.PaddingPolicy != PaddingBucket || meta.Length+meta.Padding != 12000 {
+			t.Fatalf("parity %d: padding %s %d+%d", parity, meta.PaddingPolicy, meta.Length, meta.Padding)
+		}
+	}
+	//帧数不变长度时就地追加,文件头变长时替换整个文件
+	more := filepath.Join(dir, "more.bin")
+	for _, size := range []int{5000, 200000} {
+		ioutil.WriteFile(archive, encryptBytes(t, plain[:2500], WithFrameSize(1024)), 0644)
+		before, _ := os.Stat(archive)
+		ioutil.WriteFile(more, testPlain(size), 0644)
+		err := NewEncryptor().Append(context.Background(), more, archive, "password")
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := ioutil.ReadFile(archive)
+		if !bytes.Equal(decryptBytes(t, data), append(plain[:2500:2500], testPlain(size)...)) {
+			t.Fatalf("append %d: plaintext mismatch", size)
+		}
+		after, _ := os.Stat(archive)
+		if os.SameFile(before, after) != (size == 5000) {
+			t.Fatalf("append %d: file replaced %v", size, !os.SameFile(before, after))
+		}
+	}
+	leftover, _ := filepath.Glob(filepath.Join(dir, ".zzdm-*"))
+	if len(leftover) > 0 {
+		t.Fatalf("temporary files left: %v", leftover)
+	}
+}
+
+func TestAppendAbort(t *testing.T) {
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "plain.scc")
+	original := encryptBytes(t, testPlain(2500), WithFrameSize(1024))
+	ioutil.WriteFile(archive, original, 0644)
+	writer, err := NewEncryptor().NewAppendWriter(context.Background(), archive, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	//写入中途放弃时丢弃追加的内容,原文件不变
+	_, err = writer.Write(testPlain(5000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.Abort()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertContent(t, archive, original)
+	leftover, _ := filepath.Glob(filepath.Join(dir, ".zzdm-*"))
+	if len(leftover) > 0 {
+		t.Fatalf("temporary files left: %v", leftover)
+	}
+	//Append读取输入出错时同样保留原文件,输入是目录时打开成功而读取失败
+	err = NewEncryptor().Append(context.Background(), dir, archive, "password")
+	if err == nil {
+		t.Fatalf("append a directory: %v", err)
+	}
+	assertContent(t, archive, original)
+}
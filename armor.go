@@ -0,0 +1,207 @@
+package zzdm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	//加密文件的文本格式
+	armorMessage = "ZZDM MESSAGE"
+	//每行的base64字符数
+	armorLine = 64
+	//文本加密时写入文件头的文件名
+	TextName = "message.txt"
+)
+
+//OpenPGP使用的CRC-24
+func crc24(crc uint32, data []byte) uint32 {
+	for _, b := range data {
+		crc ^= uint32(b) << 16
+		for i := 0; i < 8; i++ {
+			crc <<= 1
+			if crc&0x1000000 != 0 {
+				crc ^= 0x1864cfb
+			}
+		}
+	}
+	return crc & 0xffffff
+}
+
+const crc24Init = 0xb704ce
+
+//文本格式的开始行与结束行
+func armorBegin(block string) string {
+	return "-----BEGIN " + block + "-----"
+}
+
+func armorEnd(block string) string {
+	return "-----END " + block + "-----"
+}
+
+//按固定长度换行
+type lineWriter struct {
+	writer io.Writer
+	column int
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		size := armorLine - w.column
+		if size > len(p) {
+			size = len(p)
+		}
+		n, err := w.writer.Write(p[:size])
+		written += n
+		if err != nil {
+			return written, err
+		}
+		p = p[size:]
+		w.column += size
+		if w.column == armorLine {
+			_, err = w.writer.Write([]byte("\n"))
+			if err != nil {
+				return written, err
+			}
+			w.column = 0
+		}
+	}
+	return written, nil
+}
+
+//将加密数据流包装为文本,Close时写入校验和与结束行
+type armorWriter struct {
+	writer  io.Writer
+	block   string
+	lines   *lineWriter
+	encoder io.WriteCloser
+	crc     uint32
+}
+
+func newArmorWriter(writer io.Writer, block string) (*armorWriter, error) {
+	_, err := io.WriteString(writer, armorBegin(block)+"\n\n")
+	if err != nil {
+		return nil, err
+	}
+	lines := &lineWriter{writer: writer}
+	return &armorWriter{writer: writer, block: block, lines: lines, encoder: base64.NewEncoder(base64.StdEncoding, lines), crc: crc24Init}, nil
+}
+
+func (w *armorWriter) Write(p []byte) (int, error) {
+	w.crc = crc24(w.crc, p)
+	return w.encoder.Write(p)
+}
+
+func (w *armorWriter) Close() error {
+	err := w.encoder.Close()
+	if err != nil {
+		return err
+	}
+	if w.lines.column > 0 {
+		_, err = io.WriteString(w.writer, "\n")
+		if err != nil {
+			return err
+		}
+	}
+	checksum := []byte{byte(w.crc >> 16), byte(w.crc >> 8), byte(w.crc)}
+	_, err = io.WriteString(w.writer, "="+base64.StdEncoding.EncodeToString(checksum)+"\n"+armorEnd(w.block)+"\n")
+	return err
+}
+
+//逐行读取base64内容,遇到校验和或结束行时停止
+type armorBody struct {
+	lines    *bufio.Reader
+	end      string
+	line     []byte
+	checksum string
+	done     bool
+}
+
+func (r *armorBody) Read(p []byte) (int, error) {
+	for len(r.line) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		line, err := r.lines.ReadString('\n')
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			if err == io.EOF {
+				return 0, ErrorArmor
+			}
+			return 0, err
+		}
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, r.end) {
+			r.done = true
+		} else if strings.HasPrefix(line, "=") {
+			r.checksum = line[1:]
+		} else if len(r.checksum) > 0 {
+			return 0, ErrorArmor
+		} else {
+			r.line = []byte(line)
+		}
+	}
+	n := copy(p, r.line)
+	r.line = r.line[n:]
+	return n, nil
+}
+
+//解码文本格式的数据流,读取结束时校验CRC-24
+type armorReader struct {
+	body    *armorBody
+	decoder io.Reader
+	crc     uint32
+}
+
+//lines需要位于开始行之前
+func newArmorReader(lines *bufio.Reader, block string) (*armorReader, error) {
+	for {
+		line, err := lines.ReadString('\n')
+		if strings.TrimSpace(line) == armorBegin(block) {
+			break
+		}
+		if err != nil {
+			return nil, ErrorArmor
+		}
+	}
+	body := &armorBody{lines: lines, end: armorEnd(block)}
+	return &armorReader{body: body, decoder: base64.NewDecoder(base64.StdEncoding, body), crc: crc24Init}, nil
+}
+
+func (r *armorReader) Read(p []byte) (int, error) {
+	n, err := r.decoder.Read(p)
+	r.crc = crc24(r.crc, p[:n])
+	if err == io.EOF && len(r.body.checksum) > 0 {
+		checksum, e := base64.StdEncoding.DecodeString(r.body.checksum)
+		if e != nil || len(checksum) != 3 || uint32(checksum[0])<<16|uint32(checksum[1])<<8|uint32(checksum[2]) != r.crc {
+			return n, ErrorArmor
+		}
+	}
+	return n, err
+}
+
+//开头是否包含文本格式的开始行,允许前面有邮件正文等其他内容
+func isArmored(prefix []byte) bool {
+	return bytes.Contains(prefix, []byte(armorBegin(armorMessage)))
+}
+
+//文件开头的内容,用于识别格式,不改变读取位置
+func filePrefix(file *os.File) []byte {
+	prefix := make([]byte, 512)
+	n, _ := file.ReadAt(prefix, 0)
+	return prefix[:n]
+}
+
+//文本格式时返回解码后的数据流,否则原样返回
+func dearmor(reader io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(reader)
+	prefix, _ := buffered.Peek(512)
+	if !isArmored(prefix) {
+		return buffered, nil
+	}
+	return newArmorReader(buffered, armorMessage)
+}
@@ -0,0 +1,319 @@
+package zzdm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//并发加密多个文件,最多jobs个文件同时进行,一个文件失败不影响其他文件
+//返回与inputs对应的错误,ctx取消后还没有开始的文件返回ctx的错误
+//多个输入的输出文件相同时,输出文件属于排在最前面的输入,其余的输入按处理策略处理
+func (e *Encryptor) EncryptFiles(ctx context.Context, inputs []string, output, password string, jobs int) []error {
+	if len(e.options.OutputFile) > 0 {
+		return batchError(len(inputs), ErrorOutputFile)
+	}
+	var names *nameCipher
+	if e.options.Naming == NamingSecret {
+		var err error
+		names, err = newNameCipher(password)
+		if err != nil {
+			return batchError(len(inputs), err)
+		}
+	}
+	targets := make([]string, len(inputs))
+	for i, input := range inputs {
+		targets[i] = e.outputName(input, output, names)
+	}
+	outputs := newBatchOutputs(targets)
+	return runBatch(ctx, len(inputs), jobs, func(ctx context.Context, index int) error {
+		ctx = context.WithValue(ctx, taskKey{}, &batchTask{outputs: outputs, index: index})
+		return e.encrypt(ctx, inputs[index], output, password, names)
+	})
+}
+
+//并发解密多个文件,与EncryptFiles相同
+func (d *Decryptor) DecryptFiles(ctx context.Context, inputs []string, output, password string, jobs int) []error {
+	if len(d.options.OutputFile) > 0 {
+		return batchError(len(inputs), ErrorOutputFile)
+	}
+	//文件名密钥在第一次遇到加密的文件名时派生
+	var names *nameCipher
+	cipher := func() (*nameCipher, error) {
+		var err error
+		if names == nil {
+			names, err = newNameCipher(password)
+		}
+		return names, err
+	}
+	targets := make([]string, len(inputs))
+	for i, input := range inputs {
+		targets[i] = d.outputName(input, output, cipher)
+	}
+	outputs := newBatchOutputs(targets)
+	return runBatch(ctx, len(inputs), jobs, func(ctx context.Context, index int) error {
+		ctx = context.WithValue(ctx, taskKey{}, &batchTask{outputs: outputs, index: index})
+		return d.decrypt(ctx, inputs[index], output, password, names)
+	})
+}
+
+//加密时的输出文件名
+func (e *Encryptor) outputName(input, output string, names *nameCipher) string {
+	switch e.options.Format {
+	case FormatAge:
+		return formatEncryptionName(input, output, AgeExtension)
+	case FormatOpenSSL:
+		return formatEncryptionName(input, output, OpenSSLExtension)
+	}
+	return encryptionName(input, output, names)
+}
+
+//由文件头得到解密时的输出文件名,文本格式与旧版本加密的文件名要解开密钥之后才能确定,返回空字符串
+func (d *Decryptor) outputName(input, output string, names func() (*nameCipher, error)) string {
+	file, err := os.Open(input)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+	prefix := filePrefix(file)
+	switch {
+	case d.options.Format == FormatAge || isAge(prefix):
+		return formatDecryptionName(input, output, AgeExtension)
+	case d.options.Format == FormatOpenSSL || isOpenSSL(prefix):
+		return formatDecryptionName(input, output, OpenSSLExtension)
+	case isArmored(prefix):
+		return ""
+	}
+	header, err := ReadHead(file)
+	if err != nil || header == nil || (header.Secret && header.Naming != NamingSIV) {
+		return ""
+	}
+	var cipher *nameCipher
+	if header.Secret {
+		cipher, err = names()
+		if err != nil {
+			return ""
+		}
+	}
+	name, err := headerName(header, nil, "", cipher)
+	if err != nil {
+		return ""
+	}
+	return decryptionName(input, output, name)
+}
+
+//同一批中输出文件的归属
+type batchOutputs struct {
+	mutex sync.Mutex
+	//预先确定的输出文件所属的输入
+	owners map[string]int
+	//已经创建的输出文件所属的输入
+	created map[string]int
+}
+
+//批量处理中的一个任务
+type batchTask struct {
+	outputs *batchOutputs
+	index   int
+}
+
+type taskKey struct{}
+
+func contextTask(ctx context.Context) *batchTask {
+	task, _ := ctx.Value(taskKey{}).(*batchTask)
+	return task
+}
+
+//输出文件属于排在最前面的输入
+func newBatchOutputs(targets []string) *batchOutputs {
+	outputs := &batchOutputs{owners: map[string]int{}, created: map[string]int{}}
+	for i, target := range targets {
+		if len(target) == 0 {
+			continue
+		}
+		key := outputKey(target)
+		if _, ok := outputs.owners[key]; !ok {
+			outputs.owners[key] = i
+		}
+	}
+	return outputs
+}
+
+func outputKey(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.Clean(path)
+	}
+	return abs
+}
+
+//输出文件是否属于同一批中的其他输入,调用时需要持有锁
+func (t *batchTask) taken(path string) bool {
+	if t == nil {
+		return false
+	}
+	key := outputKey(path)
+	if owner, ok := t.outputs.owners[key]; ok && owner != t.index {
+		return true
+	}
+	creator, ok := t.outputs.created[key]
+	return ok && creator != t.index
+}
+
+//登记创建的输出文件,调用时需要持有锁
+func (t *batchTask) claim(path string) {
+	if t != nil {
+		t.outputs.created[outputKey(path)] = t.index
+	}
+}
+
+func batchError(count int, err error) []error {
+	errs := make([]error, count)
+	for i := range errs {
+		errs[i] = err
+	}
+	return errs
+}
+
+//由jobs个协程依次处理count个任务
+func runBatch(ctx context.Context, count, jobs int, task func(ctx context.Context, index int) error) []error {
+	errs := make([]error, count)
+	if jobs <= 0 {
+		jobs = 1
+	}
+	if jobs > count {
+		jobs = count
+	}
+	indexes := make(chan int)
+	var group sync.WaitGroup
+	for i := 0; i < jobs; i++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for index := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[index] = err
+					continue
+				}
+				errs[index] = task(ctx, index)
+			}
+		}()
+	}
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	group.Wait()
+	return errs
+}
+
+//按模式查找普通文件,不依赖shell展开,**匹配任意层目录,结果按路径排序
+//不含通配符的模式原样返回,是否存在由之后的处理检查
+func Glob(pattern string) ([]string, error) {
+	if !hasMeta(pattern) {
+		return []string{pattern}, nil
+	}
+	parts := strings.Split(filepath.ToSlash(pattern), "/")
+	//通配符之前的部分是遍历的起点
+	fixed := 0
+	for fixed < len(parts)-1 && !hasMeta(parts[fixed]) {
+		fixed++
+	}
+	root := strings.Join(parts[:fixed], "/")
+	if len(root) == 0 && fixed > 0 {
+		root = "/"
+	}
+	start := root
+	if len(start) == 0 {
+		start = "."
+	}
+	var matches []string
+	err := filepath.Walk(filepath.FromSlash(start), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			//无法读取的目录跳过,起点不存在时没有结果
+			if info != nil && info.IsDir() && path != start {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(filepath.FromSlash(start), path)
+		if err != nil {
+			return nil
+		}
+		if matchParts(parts[fixed:], strings.Split(filepath.ToSlash(rel), "/")) {
+			matches = append(matches, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+func hasMeta(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[")
+}
+
+//逐段匹配路径,**匹配零到多段
+func matchParts(pattern, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(path); i++ {
+			if matchParts(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 {
+		return false
+	}
+	matched, err := filepath.Match(pattern[0], path[0])
+	if err != nil || !matched {
+		return false
+	}
+	return matchParts(pattern[1:], path[1:])
+}
+
+//读取文件列表,每行一个路径,null为true时以NUL分隔,跳过空项
+func ReadFileList(reader io.Reader, null bool) ([]string, error) {
+	separator := byte('\n')
+	if null {
+		separator = 0
+	}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 4096), 1<<20)
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if index := bytes.IndexByte(data, separator); index >= 0 {
+			return index + 1, data[:index], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	})
+	var paths []string
+	for scanner.Scan() {
+		path := scanner.Text()
+		if !null {
+			path = strings.TrimRight(path, "\r")
+		}
+		if len(path) > 0 {
+			paths = append(paths, path)
+		}
+	}
+	return paths, scanner.Err()
+}
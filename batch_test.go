@@ -0,0 +1,76 @@
+package zzdm
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBatchDuplicateOutputs(t *testing.T) {
+	dir := t.TempDir()
+	//扩展名不同的同名文件加密到同一个输出文件
+	inputs := []string{filepath.Join(dir, "notes.txt"), filepath.Join(dir, "notes.md"), filepath.Join(dir, "notes.txt")}
+	ioutil.WriteFile(inputs[0], testPlain(100000), 0644)
+	ioutil.WriteFile(inputs[1], testPlain(50000)[1:], 0644)
+	cases := []struct {
+		overwrite int
+		errs      []error
+		outputs   []string
+	}{
+		{OverwriteNever, []error{nil, ErrorOutputDuplicated, ErrorOutputDuplicated}, []string{"notes.scc"}},
+		{OverwriteForce, []error{nil, ErrorOutputDuplicated, ErrorOutputDuplicated}, []string{"notes.scc"}},
+		{OverwriteRename, []error{nil, nil, nil}, []string{"notes.scc", "notes (1).scc", "notes (2).scc"}},
+	}
+	for _, c := range cases {
+		output := filepath.Join(dir, "out")
+		os.RemoveAll(output)
+		os.Mkdir(output, 0755)
+		options := []Option{WithKDF(KDFPBKDF2, 1000), WithFrameSize(1024), WithOverwrite(c.overwrite)}
+		errs := NewEncryptor(options...).EncryptFiles(context.Background(), inputs, output, "password", 4)
+		for i, err := range errs {
+			if !errors.Is(err, c.errs[i]) {
+				t.Fatalf("overwrite %d, input %d: got %v, want %v", c.overwrite, i, err, c.errs[i])
+			}
+		}
+		files, _ := ioutil.ReadDir(output)
+		if len(files) != len(c.outputs) {
+			t.Fatalf("overwrite %d: %d outputs", c.overwrite, len(files))
+		}
+		//每个输出文件都完整地来自一个输入
+		plain := filepath.Join(dir, "plain")
+		for _, name := range c.outputs {
+			os.RemoveAll(plain)
+			os.Mkdir(plain, 0755)
+			err := NewDecryptor(options...).Decrypt(context.Background(), filepath.Join(output, name), plain, "password")
+			if err != nil {
+				t.Fatalf("overwrite %d, %s: %v", c.overwrite, name, err)
+			}
+		}
+		if c.overwrite != OverwriteRename {
+			assertSameFile(t, inputs[0], filepath.Join(plain, "notes.txt"))
+		}
+	}
+
+	//不同目录中的同名文件解密到同一个目录
+	encrypted := make([]string, 0)
+	for _, name := range []string{"a", "b"} {
+		sub := filepath.Join(dir, name)
+		os.Mkdir(sub, 0755)
+		err := NewEncryptor(WithKDF(KDFPBKDF2, 1000)).Encrypt(context.Background(), inputs[0], sub, "password")
+		if err != nil {
+			t.Fatal(err)
+		}
+		files, _ := filepath.Glob(filepath.Join(sub, "*.scc"))
+		encrypted = append(encrypted, files...)
+	}
+	output := filepath.Join(dir, "decrypted")
+	os.Mkdir(output, 0755)
+	errs := NewDecryptor(WithOverwrite(OverwriteForce)).DecryptFiles(context.Background(), encrypted, output, "password", 2)
+	if errs[0] != nil || !errors.Is(errs[1], ErrorOutputDuplicated) {
+		t.Fatalf("decrypt: %v", errs)
+	}
+	assertSameFile(t, inputs[0], filepath.Join(output, "notes.txt"))
+}
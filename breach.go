@@ -0,0 +1,271 @@
+package zzdm
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	//泄露口令布隆过滤器文件的扩展名
+	BloomExtension = ".bloom"
+	//布隆过滤器的默认误报率
+	DefaultFalsePositive = 0.001
+	bloomMagic           = "ZZDMBLF1"
+	//magic、哈希函数个数、位数与条目数
+	bloomHeaderSize = 8 + 4 + 8 + 8
+	sha1HexLength   = sha1.Size * 2
+)
+
+//泄露口令列表,支持两种格式:
+//Have I Been Pwned按哈希排序的SHA-1列表,每行为"哈希:次数",以二分查找定位
+//zzdm生成的布隆过滤器,只读取需要的位,可能有误报且没有次数
+type BreachList struct {
+	file *os.File
+	size int64
+	//布隆过滤器的哈希函数个数与位数,为0时是SHA-1列表
+	hashes int
+	bits   uint64
+}
+
+//打开泄露口令列表,由文件开头识别格式
+func OpenBreachList(path string) (*BreachList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	list := &BreachList{file: file, size: stat.Size()}
+	header := make([]byte, bloomHeaderSize)
+	n, _ := file.ReadAt(header, 0)
+	if n == bloomHeaderSize && string(header[:8]) == bloomMagic {
+		list.hashes = int(binary.BigEndian.Uint32(header[8:12]))
+		list.bits = binary.BigEndian.Uint64(header[12:20])
+		if list.hashes <= 0 || list.bits == 0 || uint64(list.size-bloomHeaderSize) < (list.bits+7)/8 {
+			file.Close()
+			return nil, ErrorBreachList
+		}
+		return list, nil
+	}
+	//SHA-1列表的第一行必须是40位的十六进制哈希
+	line, _, _, err := list.lineAt(0)
+	if err != nil && err != io.EOF {
+		file.Close()
+		return nil, err
+	}
+	if _, _, ok := parseHashLine(line); !ok {
+		file.Close()
+		return nil, ErrorBreachList
+	}
+	return list, nil
+}
+
+func (b *BreachList) Close() error {
+	return b.file.Close()
+}
+
+//查找口令,返回是否在列表中以及在泄露数据中出现的次数,布隆过滤器没有次数
+func (b *BreachList) Lookup(password string) (bool, int64, error) {
+	sum := sha1.Sum([]byte(password))
+	if b.hashes > 0 {
+		found, err := b.testBloom(sum[:])
+		return found, 0, err
+	}
+	return b.searchHashes(strings.ToUpper(hex.EncodeToString(sum[:])))
+}
+
+//解析"哈希:次数",没有次数时按1次计算
+func parseHashLine(line string) (string, int64, bool) {
+	line = strings.TrimSpace(line)
+	hash, count := line, int64(1)
+	if index := strings.IndexByte(line, ':'); index >= 0 {
+		hash = line[:index]
+		n, err := strconv.ParseInt(strings.TrimSpace(line[index+1:]), 10, 64)
+		if err != nil {
+			return "", 0, false
+		}
+		count = n
+	}
+	if len(hash) != sha1HexLength {
+		return "", 0, false
+	}
+	if _, err := hex.DecodeString(hash); err != nil {
+		return "", 0, false
+	}
+	return strings.ToUpper(hash), count, true
+}
+
+//offset处或之后的第一个完整行,返回行内容、行首与下一行的位置
+func (b *BreachList) lineAt(offset int64) (string, int64, int64, error) {
+	buffer := make([]byte, 128)
+	start := offset
+	if offset > 0 {
+		//从offset-1开始找换行符,offset恰好是行首时返回这一行
+		start = -1
+		for position := offset - 1; position < b.size && start < 0; position += int64(len(buffer)) {
+			n, err := b.file.ReadAt(buffer, position)
+			if index := bytes.IndexByte(buffer[:n], '\n'); index >= 0 {
+				start = position + int64(index) + 1
+			} else if err != nil {
+				break
+			}
+		}
+	}
+	if start < 0 || start >= b.size {
+		return "", b.size, b.size, io.EOF
+	}
+	var line []byte
+	for position := start; position < b.size; position += int64(len(buffer)) {
+		n, err := b.file.ReadAt(buffer, position)
+		if index := bytes.IndexByte(buffer[:n], '\n'); index >= 0 {
+			line = append(line, buffer[:index]...)
+			return string(line), start, position + int64(index) + 1, nil
+		}
+		line = append(line, buffer[:n]...)
+		if err != nil {
+			break
+		}
+	}
+	return string(line), start, b.size, nil
+}
+
+//在按哈希排序的列表中二分查找,每次取中点之后的第一行比较
+func (b *BreachList) searchHashes(hash string) (bool, int64, error) {
+	low, high := int64(0), b.size
+	for low < high {
+		middle := low + (high-low)/2
+		line, start, next, err := b.lineAt(middle)
+		if err == io.EOF || (err == nil && start >= high) {
+			high = middle
+			continue
+		}
+		if err != nil {
+			return false, 0, err
+		}
+		key, count, ok := parseHashLine(line)
+		if !ok {
+			//空行按较大处理,格式错误的行说明不是排序的哈希列表
+			if len(strings.TrimSpace(line)) > 0 {
+				return false, 0, ErrorBreachList
+			}
+			high = middle
+			continue
+		}
+		switch {
+		case key < hash:
+			low = next
+		case key > hash:
+			high = middle
+		default:
+			return true, count, nil
+		}
+	}
+	return false, 0, nil
+}
+
+//由SHA-1摘要得到的两个哈希值,第i个位置为h1+i*h2
+func bloomIndexes(sum []byte, hashes int, bits uint64) []uint64 {
+	h1 := binary.BigEndian.Uint64(sum[0:8])
+	h2 := binary.BigEndian.Uint64(sum[8:16]) | 1
+	indexes := make([]uint64, hashes)
+	for i := range indexes {
+		indexes[i] = (h1 + uint64(i)*h2) % bits
+	}
+	return indexes
+}
+
+func (b *BreachList) testBloom(sum []byte) (bool, error) {
+	one := make([]byte, 1)
+	for _, index := range bloomIndexes(sum, b.hashes, b.bits) {
+		_, err := b.file.ReadAt(one, bloomHeaderSize+int64(index/8))
+		if err != nil {
+			return false, err
+		}
+		if one[0]&(1<<(index%8)) == 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+//由口令列表生成布隆过滤器,每行可以是明文口令,也可以是Have I Been Pwned格式的SHA-1哈希
+//恰好是40位十六进制的明文口令会被当作哈希,返回写入的条目数
+func BuildBreachFilter(input, output string, falsePositive float64, overwrite int) (int64, error) {
+	if falsePositive <= 0 || falsePositive >= 1 {
+		return 0, ErrorBreachList
+	}
+	err := prepareOutput(output, overwrite)
+	if err != nil {
+		return 0, err
+	}
+	//先数出条目数以确定过滤器的大小
+	var entries int64
+	err = breachEntries(input, func(sum []byte) {
+		entries++
+	})
+	if err != nil {
+		return 0, err
+	}
+	n := math.Max(float64(entries), 1)
+	bits := uint64(math.Ceil(-n * math.Log(falsePositive) / (math.Ln2 * math.Ln2)))
+	hashes := int(math.Max(1, math.Round(float64(bits)/n*math.Ln2)))
+	filter := make([]byte, (bits+7)/8)
+	err = breachEntries(input, func(sum []byte) {
+		for _, index := range bloomIndexes(sum, hashes, bits) {
+			filter[index/8] |= 1 << (index % 8)
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+	header := make([]byte, bloomHeaderSize)
+	copy(header, bloomMagic)
+	binary.BigEndian.PutUint32(header[8:12], uint32(hashes))
+	binary.BigEndian.PutUint64(header[12:20], bits)
+	binary.BigEndian.PutUint64(header[20:28], uint64(entries))
+	file, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	_, err = file.Write(append(header, filter...))
+	if err != nil {
+		return 0, err
+	}
+	return entries, nil
+}
+
+//逐行读取口令列表,得到每个口令的SHA-1摘要
+func breachEntries(input string, entry func(sum []byte)) error {
+	file, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	lines := bufio.NewScanner(file)
+	for lines.Scan() {
+		line := strings.TrimRight(lines.Text(), "\r")
+		if len(line) == 0 {
+			continue
+		}
+		if hash, _, ok := parseHashLine(line); ok {
+			sum, _ := hex.DecodeString(hash)
+			entry(sum)
+			continue
+		}
+		sum := sha1.Sum([]byte(line))
+		entry(sum[:])
+	}
+	return lines.Err()
+}
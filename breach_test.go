@@ -0,0 +1,119 @@
+package zzdm
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+//生成n个口令与对应的Have I Been Pwned格式的列表,次数为序号加1
+func breachPasswords(n int) ([]string, string) {
+	passwords := make([]string, n)
+	lines := make([]string, n)
+	for i := range passwords {
+		passwords[i] = fmt.Sprintf("breached-%d", i)
+		sum := sha1.Sum([]byte(passwords[i]))
+		lines[i] = fmt.Sprintf("%s:%d", strings.ToUpper(hex.EncodeToString(sum[:])), i+1)
+	}
+	sort.Strings(lines)
+	return passwords, strings.Join(lines, "\r\n") + "\r\n"
+}
+
+func TestBreachListSearch(t *testing.T) {
+	dir := t.TempDir()
+	passwords, content := breachPasswords(2000)
+	path := filepath.Join(dir, "hibp.txt")
+	ioutil.WriteFile(path, []byte(content), 0644)
+	list, err := OpenBreachList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer list.Close()
+	//包括第一行与最后一行在内的每个条目都能找到
+	for i, password := range passwords {
+		found, count, err := list.Lookup(password)
+		if err != nil || !found || count != int64(i+1) {
+			t.Fatalf("%s: got %v %d %v", password, found, count, err)
+		}
+	}
+	for i := 0; i < 2000; i++ {
+		found, _, err := list.Lookup(fmt.Sprintf("missing-%d", i))
+		if err != nil || found {
+			t.Fatalf("missing-%d: got %v %v", i, found, err)
+		}
+	}
+	//没有次数的列表与只有一行的列表
+	sum := sha1.Sum([]byte("only"))
+	single := filepath.Join(dir, "single.txt")
+	ioutil.WriteFile(single, []byte(hex.EncodeToString(sum[:])), 0644)
+	list, err = OpenBreachList(single)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer list.Close()
+	if found, count, _ := list.Lookup("only"); !found || count != 1 {
+		t.Fatalf("single line: got %v %d", found, count)
+	}
+	if found, _, _ := list.Lookup("other"); found {
+		t.Fatal("single line: other password found")
+	}
+	invalid := filepath.Join(dir, "invalid.txt")
+	ioutil.WriteFile(invalid, []byte("password\n123456\n"), 0644)
+	if _, err := OpenBreachList(invalid); !errors.Is(err, ErrorBreachList) {
+		t.Fatalf("plain text list: %v", err)
+	}
+}
+
+func TestBreachFilter(t *testing.T) {
+	dir := t.TempDir()
+	passwords, hashes := breachPasswords(5000)
+	//明文口令与SHA-1哈希可以混在同一个输入中
+	input := filepath.Join(dir, "input.txt")
+	ioutil.WriteFile(input, []byte(strings.Join(passwords[:2500], "\n")+"\n"+hashes), 0644)
+	output := input + BloomExtension
+	entries, err := BuildBreachFilter(input, output, DefaultFalsePositive, OverwriteNever)
+	if err != nil || entries != 7500 {
+		t.Fatalf("build: %d %v", entries, err)
+	}
+	filter, err := OpenBreachList(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer filter.Close()
+	for _, password := range passwords {
+		found, _, err := filter.Lookup(password)
+		if err != nil || !found {
+			t.Fatalf("%s: got %v %v", password, found, err)
+		}
+	}
+	//误报率接近构建时指定的值
+	positives := 0
+	for i := 0; i < 20000; i++ {
+		found, _, _ := filter.Lookup(fmt.Sprintf("missing-%d", i))
+		if found {
+			positives++
+		}
+	}
+	if positives > 20000*DefaultFalsePositive*3 {
+		t.Fatalf("%d false positives", positives)
+	}
+	if _, err := BuildBreachFilter(input, output, DefaultFalsePositive, OverwriteNever); !errors.Is(err, ErrorFileDuplicated) {
+		t.Fatalf("existing output: %v", err)
+	}
+	if _, err := BuildBreachFilter(input, filepath.Join(dir, "other.bloom"), 1, OverwriteNever); !errors.Is(err, ErrorBreachList) {
+		t.Fatalf("false positive rate 1: %v", err)
+	}
+	//截断的过滤器
+	data, _ := ioutil.ReadFile(output)
+	truncated := filepath.Join(dir, "truncated.bloom")
+	ioutil.WriteFile(truncated, data[:len(data)/2], 0644)
+	if _, err := OpenBreachList(truncated); !errors.Is(err, ErrorBreachList) {
+		t.Fatalf("truncated filter: %v", err)
+	}
+}
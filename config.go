@@ -0,0 +1,464 @@
+package zzdm
+
+import (
+	"encoding"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+const (
+	//项目配置文件名,从当前目录向上查找
+	ProjectConfigName = ".zzdm.toml"
+	//环境变量前缀,配置项名称转为大写、点号换成下划线,如ZZDM_POLICY_MIN_LENGTH
+	ConfigEnvPrefix = "ZZDM_"
+	//默认值的来源
+	SourceDefault = "default"
+)
+
+//项目配置文件可能来自任意的上级目录,默认只能设置这些项
+//其余的项需要在系统配置、用户配置或环境变量中设置project_config = true
+var projectKeys = []string{"naming", "frame_size"}
+
+//配置文件中输出文件命名策略的名称
+var namingNames = map[string]int{
+	"original": NamingOriginal,
+	"secret":   NamingSecret,
+}
+
+//分层配置,依次为默认值、系统配置、用户配置、项目配置、ZZDM_*环境变量与命令行参数,后面的覆盖前面的
+//标记为path的项在配置文件中是相对路径时相对于配置文件所在的目录
+type Config struct {
+	//输出目录
+	Output string `toml:"output" zzdm:"path"`
+	//输出文件命名策略,original或secret
+	Naming string `toml:"naming"`
+	//覆盖已存在的输出文件,与on_conflict = "overwrite"相同
+	Force bool `toml:"force"`
+	//输出文件已存在时的处理策略:error、overwrite、skip、rename或newer
+	OnConflict string `toml:"on_conflict"`
+	//每帧的明文字节数
+	FrameSize int64  `toml:"frame_size"`
+	Cipher    string `toml:"cipher"`
+	KDF       string `toml:"kdf"`
+	KDFCost   int    `toml:"kdf_cost"`
+	//--remove-source删除源文件前覆盖随机数据的次数
+	ShredPasses int `toml:"shred_passes"`
+	//口令策略
+	Policy Policy `toml:"policy"`
+	//泄露口令列表
+	BreachLists []string `toml:"breach_lists" zzdm:"path"`
+	//密钥来源
+	Keys KeySources `toml:"keys"`
+	//项目配置文件可以设置全部的项,项目配置文件本身不能设置
+	ProjectConfig bool `toml:"project_config"`
+	//每一项的来源
+	sources map[string]string
+	//读取的项目配置文件
+	project string
+}
+
+//密钥来源
+type KeySources struct {
+	//age格式的X25519公钥
+	Recipients []string `toml:"recipients"`
+	//age身份文件
+	Identities []string `toml:"identities" zzdm:"path"`
+	//签名私钥
+	SigningKey string `toml:"signing_key" zzdm:"path"`
+	//受信任的签名公钥文件
+	Signers []string `toml:"signers" zzdm:"path"`
+}
+
+//配置项及其来源
+type ConfigSetting struct {
+	Key string `json:"key"`
+	//TOML格式的值
+	Value  string `json:"value"`
+	Source string `json:"source"`
+}
+
+type configField struct {
+	key   string
+	value reflect.Value
+	path  bool
+}
+
+//默认配置,与DefaultOptions一致
+func DefaultConfig() *Config {
+	options := DefaultOptions()
+	config := &Config{
+		Naming:      "original",
+		OnConflict:  "error",
+		FrameSize:   options.FrameSize,
+		Cipher:      options.Cipher,
+		KDF:         options.KDF,
+		KDFCost:     options.KDFCost,
+		ShredPasses: DefaultShredPasses,
+		sources:     map[string]string{},
+	}
+	for _, field := range config.fields() {
+		config.sources[field.key] = SourceDefault
+	}
+	return config
+}
+
+//依次读取存在的配置文件、项目配置文件与ZZDM_*环境变量
+//环境变量先读取一次以确定是否允许项目配置文件设置全部的项
+func LoadConfig() (*Config, error) {
+	config := DefaultConfig()
+	for _, path := range ConfigFiles() {
+		if !Exist(path) {
+			continue
+		}
+		err := config.LoadFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := config.LoadEnv(os.Environ())
+	if err != nil {
+		return nil, err
+	}
+	if path := ProjectConfigFile(); len(path) > 0 {
+		err = config.LoadProjectFile(path)
+		if err != nil {
+			return nil, err
+		}
+		err = config.LoadEnv(os.Environ())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+//按优先级从低到高排列的系统与用户配置文件路径
+func ConfigFiles() []string {
+	var files []string
+	if runtime.GOOS == "windows" {
+		if data := os.Getenv("ProgramData"); len(data) > 0 {
+			files = append(files, filepath.Join(data, "zzdm", "config.toml"))
+		}
+	} else {
+		files = append(files, "/etc/zzdm/config.toml")
+	}
+	home := os.Getenv("XDG_CONFIG_HOME")
+	if len(home) == 0 {
+		if dir, err := os.UserHomeDir(); err == nil {
+			home = filepath.Join(dir, ".config")
+		}
+	}
+	if len(home) > 0 {
+		files = append(files, filepath.Join(home, "zzdm", "config.toml"))
+	}
+	return files
+}
+
+//从当前目录向上找到的第一个项目配置文件,没有时为空
+func ProjectConfigFile() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		path := filepath.Join(dir, ProjectConfigName)
+		if Exist(path) {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+//全部配置项,按结构体中的顺序,嵌套的表以点号连接
+func (c *Config) fields() []configField {
+	var fields []configField
+	var walk func(prefix string, value reflect.Value)
+	walk = func(prefix string, value reflect.Value) {
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			name := field.Tag.Get("toml")
+			if len(name) == 0 {
+				continue
+			}
+			if field.Type.Kind() == reflect.Struct {
+				walk(prefix+name+".", value.Field(i))
+				continue
+			}
+			fields = append(fields, configField{key: prefix + name, value: value.Field(i), path: field.Tag.Get("zzdm") == "path"})
+		}
+	}
+	walk("", reflect.ValueOf(c).Elem())
+	return fields
+}
+
+func (c *Config) field(key string) (configField, bool) {
+	for _, field := range c.fields() {
+		if field.key == key {
+			return field, true
+		}
+	}
+	return configField{}, false
+}
+
+//读取TOML配置文件,只覆盖文件中出现的项,不认识的项视为错误
+func (c *Config) LoadFile(path string) error {
+	return c.decodeFile(path, c, "", path)
+}
+
+//读取项目配置文件,没有设置ProjectConfig时只能包含projectKeys中的项
+func (c *Config) LoadProjectFile(path string) error {
+	meta, err := toml.DecodeFile(path, &Config{})
+	if err != nil {
+		return err
+	}
+	for _, field := range c.fields() {
+		if !meta.IsDefined(strings.Split(field.key, ".")...) {
+			continue
+		}
+		allowed := c.ProjectConfig && field.key != "project_config"
+		for _, key := range projectKeys {
+			allowed = allowed || key == field.key
+		}
+		if !allowed {
+			return fmt.Errorf("%s: %w: %s", path, ErrorConfigProject, field.key)
+		}
+	}
+	err = c.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	c.project = path
+	return nil
+}
+
+//读取的项目配置文件,没有时为空
+func (c *Config) ProjectFile() string {
+	return c.project
+}
+
+//读取口令策略文件作为policy表,来源记为source
+func (c *Config) LoadPolicyFile(path, source string) error {
+	err := c.decodeFile(path, &c.Policy, "policy.", source)
+	if err != nil {
+		return err
+	}
+	return c.Policy.Validate()
+}
+
+func (c *Config) decodeFile(path string, target interface{}, prefix, source string) error {
+	meta, err := toml.DecodeFile(path, target)
+	if err != nil {
+		return err
+	}
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		return fmt.Errorf("%s: %w: %s", path, ErrorConfigKey, undecoded[0])
+	}
+	dir := filepath.Dir(path)
+	for _, field := range c.fields() {
+		if !strings.HasPrefix(field.key, prefix) || !meta.IsDefined(strings.Split(strings.TrimPrefix(field.key, prefix), ".")...) {
+			continue
+		}
+		c.sources[field.key] = source
+		if field.path {
+			resolvePaths(field.value, dir)
+		}
+	}
+	return nil
+}
+
+//相对路径改为相对于dir
+func resolvePaths(value reflect.Value, dir string) {
+	resolve := func(path string) string {
+		if len(path) == 0 || filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(dir, path)
+	}
+	if value.Kind() == reflect.String {
+		value.SetString(resolve(value.String()))
+		return
+	}
+	for i := 0; i < value.Len(); i++ {
+		value.Index(i).SetString(resolve(value.Index(i).String()))
+	}
+}
+
+//读取ZZDM_*环境变量,列表以逗号分隔,环境中的相对路径相对于当前目录
+func (c *Config) LoadEnv(environ []string) error {
+	values := map[string]string{}
+	for _, entry := range environ {
+		if index := strings.IndexByte(entry, '='); index > 0 && strings.HasPrefix(entry, ConfigEnvPrefix) {
+			values[entry[:index]] = entry[index+1:]
+		}
+	}
+	for _, field := range c.fields() {
+		name := ConfigEnvPrefix + strings.ToUpper(strings.ReplaceAll(field.key, ".", "_"))
+		value, ok := values[name]
+		if !ok {
+			continue
+		}
+		err := c.Set(field.key, value, "env "+name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//由文本设置一项,列表以逗号分隔
+func (c *Config) Set(key, text, source string) error {
+	field, ok := c.field(key)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrorConfigKey, key)
+	}
+	value := field.value
+	var err error
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		err = unmarshaler.UnmarshalText([]byte(text))
+	} else {
+		switch value.Kind() {
+		case reflect.String:
+			value.SetString(text)
+		case reflect.Bool:
+			var b bool
+			if b, err = strconv.ParseBool(text); err == nil {
+				value.SetBool(b)
+			}
+		case reflect.Int, reflect.Int64:
+			var n int64
+			if n, err = strconv.ParseInt(text, 10, 64); err == nil {
+				value.SetInt(n)
+			}
+		case reflect.Float64:
+			var f float64
+			if f, err = strconv.ParseFloat(text, 64); err == nil {
+				value.SetFloat(f)
+			}
+		case reflect.Slice:
+			var list []string
+			for _, item := range strings.Split(text, ",") {
+				if item = strings.TrimSpace(item); len(item) > 0 {
+					list = append(list, item)
+				}
+			}
+			value.Set(reflect.ValueOf(list))
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s=%q", ErrorConfigValue, key, text)
+	}
+	c.sources[key] = source
+	return nil
+}
+
+//设置列表项,用于可以重复的命令行参数
+func (c *Config) SetList(key string, list []string, source string) error {
+	field, ok := c.field(key)
+	if !ok || field.value.Kind() != reflect.Slice {
+		return fmt.Errorf("%w: %s", ErrorConfigKey, key)
+	}
+	field.value.Set(reflect.ValueOf(append([]string{}, list...)))
+	c.sources[key] = source
+	return nil
+}
+
+//一项的来源
+func (c *Config) Source(key string) string {
+	return c.sources[key]
+}
+
+//全部配置项的当前值与来源
+func (c *Config) Settings() []ConfigSetting {
+	var settings []ConfigSetting
+	for _, field := range c.fields() {
+		value := field.value.Interface()
+		text := fmt.Sprint(value)
+		switch v := value.(type) {
+		case string:
+			text = strconv.Quote(v)
+		case []string:
+			quoted := make([]string, len(v))
+			for i, item := range v {
+				quoted[i] = strconv.Quote(item)
+			}
+			text = "[" + strings.Join(quoted, ", ") + "]"
+		case Duration:
+			text = strconv.Quote(v.String())
+		}
+		settings = append(settings, ConfigSetting{Key: field.key, Value: text, Source: c.sources[field.key]})
+	}
+	return settings
+}
+
+//检查配置是否有效
+func (c *Config) Validate() error {
+	if _, ok := namingNames[c.Naming]; !ok {
+		names := make([]string, 0, len(namingNames))
+		for name := range namingNames {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("%w: naming must be one of %s", ErrorConfigValue, strings.Join(names, ", "))
+	}
+	if _, err := ParseConflict(c.OnConflict); err != nil {
+		return err
+	}
+	if c.FrameSize <= 0 {
+		return fmt.Errorf("%w: frame_size must be positive", ErrorConfigValue)
+	}
+	if _, err := keyLength(c.Cipher); err != nil {
+		return err
+	}
+	if c.ShredPasses < 0 {
+		return fmt.Errorf("%w: shred_passes cannot be negative", ErrorConfigValue)
+	}
+	switch c.KDF {
+	case KDFNone:
+	case KDFPBKDF2:
+		if c.KDFCost <= 0 {
+			return fmt.Errorf("%w: kdf_cost must be positive", ErrorConfigValue)
+		}
+	default:
+		return ErrorKDF
+	}
+	return c.Policy.Validate()
+}
+
+//输出文件命名策略
+func (c *Config) NamingPolicy() int {
+	return namingNames[c.Naming]
+}
+
+//输出文件已存在时的处理策略,force只在on_conflict为error时生效
+func (c *Config) Overwrite() int {
+	overwrite, _ := ParseConflict(c.OnConflict)
+	if overwrite == OverwriteNever && c.Force {
+		return OverwriteForce
+	}
+	return overwrite
+}
+
+//由配置得到的加解密配置项
+func (c *Config) Options() []Option {
+	return []Option{
+		WithNaming(c.NamingPolicy()),
+		WithOverwrite(c.Overwrite()),
+		WithFrameSize(c.FrameSize),
+		WithCipher(c.Cipher),
+		WithKDF(c.KDF, c.KDFCost),
+		WithRecipients(c.Keys.Recipients...),
+		WithIdentities(c.Keys.Identities...),
+	}
+}
@@ -0,0 +1,43 @@
+package zzdm
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadProjectFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ProjectConfigName)
+	cases := []struct {
+		content string
+		trusted bool
+		err     error
+	}{
+		{"frame_size = 2048\nnaming = \"secret\"\n", false, nil},
+		{"frame_size = 2048\n[keys]\nrecipients = [\"age1\"]\n", false, ErrorConfigProject},
+		{"kdf_cost = 1\n", false, ErrorConfigProject},
+		{"output = \"/tmp\"\n", false, ErrorConfigProject},
+		{"[policy]\nmin_length = 0\n", false, ErrorConfigProject},
+		{"kdf_cost = 1000\n[keys]\nrecipients = [\"age1\"]\n", true, nil},
+		//项目配置文件不能给自己授权
+		{"project_config = true\nkdf_cost = 1\n", false, ErrorConfigProject},
+		{"project_config = true\n", true, ErrorConfigProject},
+	}
+	for _, c := range cases {
+		ioutil.WriteFile(path, []byte(c.content), 0644)
+		config := DefaultConfig()
+		config.ProjectConfig = c.trusted
+		err := config.LoadProjectFile(path)
+		if !errors.Is(err, c.err) {
+			t.Errorf("%q: got %v, want %v", c.content, err, c.err)
+			continue
+		}
+		if err == nil && (config.FrameSize != 2048 && config.KDFCost != 1000 || config.ProjectFile() != path) {
+			t.Errorf("%q: not loaded", c.content)
+		}
+		if err != nil && config.Source("kdf_cost") != SourceDefault {
+			t.Errorf("%q: applied", c.content)
+		}
+	}
+}
@@ -1,9 +1,9 @@
 package zzdm
 
 import (
-	"os"
 	"errors"
 	"fmt"
+	"os"
 )
 
 const (
@@ -29,7 +29,48 @@ var (
 	ErrorAES              = errors.New("aes error")
 	ErrorInvalidFile      = errors.New(fmt.Sprintf("not a valid %s file", Extension))
 	ErrorFileDuplicated   = errors.New("output file already exists,to overwrite it,specify the flag --force")
+	ErrorOutputDuplicated = errors.New("another input of the batch has the same output file")
 	ErrorDataMissing      = errors.New("no more bytes to read")
 	ErrorFrameMissing     = errors.New("mssing frames")
 	ErrorChecksumMismatch = errors.New("checksum mismatch")
+	ErrorCipher           = errors.New("unsupported cipher suite")
+	ErrorKDF              = errors.New("unsupported key derivation function")
+	ErrorPadding          = errors.New("invalid padding")
+	ErrorOutputDir        = errors.New("an output directory is required in recursive mode")
+	ErrorAuthentication   = errors.New("authentication failed, wrong password or damaged file")
+	ErrorParity           = errors.New("invalid parity configuration")
+	ErrorNoParity         = errors.New("file has no parity frames")
+	ErrorRepair           = errors.New("too many damaged frames to repair")
+	ErrorResume           = errors.New("the partial output does not match the input")
+	ErrorFormat           = errors.New("unsupported file format")
+	ErrorPassword         = errors.New("a password or an age key is required")
+	ErrorArmor            = errors.New("invalid or damaged armored message")
+	ErrorAppend           = errors.New("only files in the current format can be appended, run migrate first")
+	ErrorSign             = errors.New("only files in the current format can be signed, run migrate first")
+	ErrorSigningKey       = errors.New("invalid Ed25519 signing key")
+	ErrorSignature        = errors.New("invalid signature")
+	ErrorUnsigned         = errors.New("file is not signed")
+	ErrorUntrusted        = errors.New("file is signed by an untrusted key")
+	ErrorShares           = errors.New("invalid share configuration, the threshold must be at least 2 and at most the number of shares")
+	ErrorShareMissing     = errors.New("not enough shares to rebuild the key")
+	ErrorShareMismatch    = errors.New("share belongs to another file")
+	ErrorShareOutput      = errors.New("shares can only be written when encrypting files")
+	ErrorBreachList       = errors.New("not a sorted SHA-1 password list or a zzdm bloom filter")
+	ErrorBreached         = errors.New("password was found in a breached password list")
+	ErrorPolicy           = errors.New("password does not meet the policy")
+	ErrorPolicyClass      = errors.New("unknown character class, expected upper, lower, digit or symbol")
+	ErrorPolicyLimit      = errors.New("password policy limits cannot be negative")
+	ErrorPasswordAge      = errors.New("password is older than the maximum age of the policy, re-encrypt the file with a new password")
+	ErrorGenerate         = errors.New("a generated password must have between 1 and 1024 characters or words")
+	ErrorDuration         = errors.New("invalid duration, use a number of days like 90d or a duration like 2160h")
+	ErrorConfigKey        = errors.New("unknown configuration key")
+	ErrorConfigValue      = errors.New("invalid configuration value")
+	ErrorConfigProject    = errors.New("configuration key is not allowed in a project file unless project_config = true is set in the system or user configuration")
+	ErrorConflict         = errors.New("unknown conflict strategy, expected error, overwrite, skip, rename or newer")
+	ErrorOutputFile       = errors.New("an output file can only be used with a single input")
+	ErrorRoundTrip        = errors.New("the encrypted file does not decrypt to the source, the source was kept")
+	ErrorShred            = errors.New("only regular files can be shredded")
+	ErrorRemoveSource     = errors.New("an output encrypted to age recipients cannot be checked without an identity, the source cannot be removed")
+	ErrorEdit             = errors.New("only files in the current format can be edited, run migrate first")
+	ErrorEditSigned       = errors.New("the file is signed, the signing key of its signer is required to sign the edited file")
 )
@@ -0,0 +1,1621 @@
+package zzdm
+
+//口令强度估计使用的字典,英文部分从zxcvbn(MIT许可)的词频数据中截取,许可与署名见NOTICE
+//每个列表按常见程度排序,位置即排名,最后是生成口令短语的词表
+
+//常见口令,来自泄露的口令统计
+const passwordsList = `
+password 123456 12345678 1234 qwerty 12345 dragon pussy baseball football letmein monkey 696969
+abc123 mustang shadow master 111111 2000 jordan superman harley 1234567 fuckme hunter fuckyou
+trustno1 ranger buster tigger soccer fuck batman test pass killer hockey charlie love sunshine
+asshole 6969 pepper access 123456789 654321 maggie starwars silver dallas yankees 123123 666666
+hello orange biteme freedom computer sexy thunder ginger hammer summer corvette fucker austin 1111
+merlin 121212 golfer cheese princess chelsea diamond yellow bigdog secret asdfgh sparky cowboy
+camaro matrix falcon iloveyou guitar purple scooter phoenix aaaaaa tigers porsche mickey maverick
+cookie nascar peanut 131313 money horny samantha panties steelers snoopy boomer whatever iceman
+smokey gateway dakota cowboys eagles chicken dick black zxcvbn ferrari knight hardcore compaq
+coffee booboo bitch bulldog xxxxxx welcome player ncc1701 wizard scooby junior internet bigdick
+brandy tennis blowjob banana monster spider lakers rabbit enter mercedes fender yamaha diablo
+boston tiger marine chicago rangers gandalf winter bigtits barney raiders porn badboy blowme spanky
+bigdaddy chester london midnight blue fishing 000000 hannah slayer 11111111 sexsex redsox thx1138
+asdf marlboro panther zxcvbnm arsenal qazwsx mother 7777777 jasper winner golden butthead viking
+iwantu angels prince cameron girls madison hooters startrek captain maddog jasmine butter booger
+golf rocket theman liverpoo flower forever muffin turtle sophie redskins toyota sierra winston
+giants packers newyork casper bubba 112233 lovers mountain united driver helpme fucking pookie
+lucky maxwell 8675309 bear suckit gators 5150 222222 shithead fuckoff jaguar hotdog tits gemini
+lover xxxxxxxx 777777 canada florida 88888888 rosebud metallic doctor trouble success stupid tomcat
+warrior peaches apples fish qwertyui magic buddy dolphins rainbow gunner 987654 freddy alexis
+braves cock 2112 1212 cocacola xavier dolphin testing bond007 member voodoo 7777 samson apollo fire
+tester beavis voyager porno rush2112 beer apple scorpio skippy sydney red123 power beaver star
+jackass flyers boobs 232323 zzzzzz scorpion doggie legend ou812 yankee blazer runner birdie bitches
+555555 topgun asdfasdf heaven viper animal 2222 bigboy 4444 private godzilla lifehack phantom rock
+august sammy cool platinum jake bronco heka6w2 copper cumshot garfield willow cunt slut 69696969
+kitten super jordan23 eagle1 shelby america 11111 free 123321 chevy bullshit broncos horney surfer
+nissan 999999 saturn airborne elephant shit action adidas qwert 1313 explorer police christin
+december wolf sweet therock online dickhead brooklyn cricket racing penis 0000 teens redwings
+dreams michigan hentai magnum 87654321 donkey trinity digital 333333 cartman guinness 123abc speedy
+buffalo kitty pimpin eagle einstein nirvana vampire xxxx playboy pumpkin snowball test123 sucker
+mexico beatles fantasy celtic cherry cassie 888888 sniper genesis hotrod reddog alexande college
+jester passw0rd bigcock lasvegas slipknot 3333 death 1q2w3e eclipse 1q2w3e4r drummer montana music
+aaaa carolina colorado creative hello1 goober friday bollocks scotty abcdef bubbles hawaii fluffy
+horses thumper 5555 pussies darkness asdfghjk boobies buddha sandman naughty honda azerty 6666
+shorty money1 beach loveme 4321 simple poohbear 444444 badass destiny vikings lizard assman
+nintendo 123qwe november xxxxx october leather bastard 101010 extreme password1 pussy1 lacrosse
+hotmail spooky amateur alaska badger paradise maryjane poop mozart video vagina spitfire cherokee
+cougar 420420 horse enigma raider brazil blonde 55555 dude drowssap lovely 1qaz2wsx booty snickers
+nipples diesel rocks eminem westside suzuki passion hummer ladies alpha suckme 147147 pirate
+semperfi jupiter redrum freeuser wanker stinky ducati paris babygirl windows spirit pantera monday
+patches brutus smooth penguin marley forest cream 212121 flash maximus nipple vision pokemon
+champion fireman indian softball picard system cobra enjoy lucky1 boogie marines security dirty
+admin wildcats pimp dancer hardon fucked abcd1234 abcdefg ironman wolverin freepass bigred squirt
+justice hobbes pearljam mercury domino 9999 rascal hitman mistress bbbbbb peekaboo naked budlight
+electric sluts stargate saints bondage bigman zombie swimming duke qwerty1 babes scotland disney
+rooster mookie swordfis hunting blink182 8888 samsung bubba1 whore general passport aaaaaaaa erotic
+liberty arizona abcd newport skipper rolltide balls happy1 galore christ weasel 242424 wombat
+digger classic bulldogs poopoo accord popcorn turkey bunny mouse 007007 titanic liverpool dreamer
+everton chevelle psycho nemesis pontiac connor eatme lickme cumming ireland spiderma patriots
+goblue devils empire asdfg cardinal shaggy froggy qwer kawasaki kodiak phpbb 54321 chopper hooker
+whynot lesbian snake teen ncc1701d qqqqqq airplane britney avalon sugar sublime wildcat raven
+scarface elizabet 123654 trucks wolfpack pervert redhead american bambam woody shaved snowman
+tiger1 chicks raptor 1969 stingray shooter france stars madmax sports 789456 simpsons lights
+chronic hahaha packard hendrix service spring srinivas spike 252525 bigmac suck single popeye
+tattoo texas bullet taurus sailor wolves panthers japan strike pussycat chris1 loverboy berlin
+sticky tarheels russia wolfgang testtest mature catch22 juice michael1 nigger 159753 alpha1 trooper
+hawkeye freaky dodgers pakistan machine pyramid vegeta katana moose tinker coyote infinity pepsi
+letmein1 bang hercules james1 tickle outlaw browns billybob pickle test1 sucks pavilion changeme
+caesar prelude darkside bowling wutang sunset alabama danger zeppelin pppppp 2001 ping darkstar
+madonna qwe123 bigone casino charlie1 mmmmmm integra wrangler apache tweety qwerty12 bobafett
+transam 2323 seattle ssssss openup pandora pussys trucker indigo storm malibu weed review babydoll
+doggy dilbert pegasus joker catfish flipper fuckit detroit cheyenne bruins smoke marino fetish
+xfiles stinger pizza babe stealth manutd gundam cessna longhorn presario mnbvcxz wicked mustang1
+victory 21122112 awesome athena q1w2e3r4 holiday knicks redneck 12341234 gizmo scully dragon1
+devildog triumph bluebird shotgun peewee angel1 metallica madman impala lennon omega access14
+enterpri search smitty blizzard unicorn tight asdf1234 trigger truck beauty thailand 1234567890
+cadillac castle bobcat buddy1 sunny stones asian butt loveyou hellfire hotsex indiana panzer
+lonewolf trumpet colors blaster 12121212 fireball precious jungle atlanta gold corona polaris
+timber theone baller chipper skyline dragons dogs licker engineer kong pencil basketba hornet
+barbie wetpussy indians redman foobar travel morpheus target 141414 hotstuff photos rocky1
+fuck_inside dollar turbo design hottie 202020 blondes 4128 lestat avatar goforit random abgrtyu
+jjjjjj cancer q1w2e3 smiley express virgin zipper wrinkle1 babylon consumer monkey1 serenity
+samurai 99999999 bigboobs skeeter joejoe master1 aaaaa chocolat christia stephani tang 1234qwer
+98765432 sexual maxima 77777777 buckeye highland seminole reaper bassman nugget lucifer airforce
+nasty warlock 2121 dodge chrissy burger snatch pink gang maddie huskers piglet photo dodger paladin
+chubby buckeyes hamlet abcdefgh bigfoot sunday manson goldfish garden deftones icecream blondie
+spartan charger stormy juventus galaxy escort zxcvb planet blues david1 ncc1701e 1966 51505150
+cavalier gambit ripper oicu812 nylons aardvark whiskey bing plastic anal babylon5 loser racecar
+insane yankees1 mememe hansolo chiefs fredfred freak frog salmon concrete zxcv shamrock atlantis
+wordpass rommel 1010 predator massive cats sammy1 mister stud marathon rubber ding trunks desire
+montreal justme faster irish 1999 jessica1 alpine diamonds 00000 swinger shan stallion pitbull
+letmein2 ming shadow1 clitoris fuckers jackoff bluesky sundance renegade hollywoo 151515 wolfman
+soldier ling goddess manager sweety titans fang ficken niners bubble hello123 ibanez sweetpea
+stocking 323232 tornado content aragorn trojan christop rockstar geronimo pascal crimson google
+fatcat lovelove cunts stimpy finger wheels viper1 latin greenday 987654321 creampie hiphop snapper
+funtime duck trombone adult cookies mulder westham latino jeep ravens drizzt madness energy kinky
+314159 slick rocker 55555555 mongoose speed dddddd catdog cheng ghost gogogo tottenha curious
+butterfl mission january shark techno lancer lalala chichi orion trixie delta bobbob bomber kang
+1968 spunky liquid beagle granny network kkkkkk 1973 biggie beetle teacher toronto anakin genius
+cocks dang karate snakes bangkok fuckyou2 pacific daytona infantry skywalke sailing raistlin
+vanhalen huang blackie tarzan strider sherlock gong dietcoke ultimate shai sprite ting artist chai
+chao devil python ninja ytrewq superfly 456789 tian jing jesus1 freedom1 drpepper chou hobbit shen
+nolimit mylove biscuit yahoo shasta sex4me smoker pebbles pics philly tong tintin lesbians cactus
+frank1 tttttt chun danni emerald showme pirates lian dogg xiao xian tazman tanker toshiba gotcha
+rang keng jazz bigguy yuan tomtom chaos fossil racerx creamy bobo musicman warcraft blade shuang
+shun lick jian microsoft rong feng getsome quality 1977 beng wwwwww yoyoyo zhang seng harder qazxsw
+qian cong chuan deng nang boeing keeper western 1963 subaru sheng thuglife teng jiong miao mang
+maniac pussie a1b2c3 zhou zhuang xing stonecol spyder liang jiang memphis ceng magic1 logitech
+chuang sesame shao poison titty kuan kuai mian guan hamster guai ferret geng duan pang maiden quan
+velvet nong neng nookie buttons bian bingo biao zhong zeng zhun ying zong xuan zang 0.0.000 suan
+shei shui sharks shang shua peng pian piao liao meng miami reng guang cang ruan diao luan qing chui
+chuo cuan nuan ning heng huan kansas muscle weng 1passwor bluemoon zhui zhua xiang zheng zhen zhei
+zhao zhan yomama zhai zhuo zuan tarheel shou shuo tiao leng kuang jiao 13579 basket qiao qiong
+qiang chuai nian niao niang huai 22222222 zhuan zhuai shuan shuai stardust jumper 66666666 charlott
+qwertz bones waterloo 2002 11223344 oldman trains vertigo 246810 black1 swallow smiles standard
+alexandr parrot user 1976 surfing pioneer apple1 asdasd auburn hannibal frontier panama welcome1
+vette blue22 shemale 111222 baggins groovy global 181818 1979 blades spanking byteme lobster dawg
+japanese 1970 1964 2424 polo coco deedee mikey 1972 171717 1701 strip jersey green1 capital putter
+vader seven7 banshee grendel dicks hidden iloveu 1980 ledzep 147258 female bugger buffett molson
+2020 wookie sprint jericho 102030 ranger1 trebor deepthroat bonehead molly1 mirage models 1984 2468
+showtime squirrel pentium anime gator powder twister connect neptune engine eatshit mustangs woody1
+shogun septembe pooh jimbo russian sabine voyeur 2525 363636 camel germany giant qqqq nudist bone
+sleepy tequila fighter obiwan makaveli vacation walnut 1974 ladybug cantona ccbill satan rusty1
+passwor1 columbia kissme motorola william1 1967 zzzz skater smut matthew1 valley coolio dagger
+boner bull horndog jason1 penguins rescue griffey 8j4ye3uz californ champs qwertyuiop portland
+colt45 xxxxxxx xanadu tacoma carpet gggggg safety palace italia picturs picasso thongs tempest
+asd123 hairy foxtrot nimrod hotboy 343434 1111111 asdfghjkl goose overlord stranger 454545 shaolin
+sooners socrates spiderman peanuts 13131313 andrew1 filthy ohyeah africa intrepid pickles assass
+fright potato hhhhhh kingdom weezer 424242 pepsi1 throat looker puppy butch sweets megadeth analsex
+nymets ddddddd bigballs oakland oooooo qweasd chucky carrot chargers discover dookie condor horny1
+sunrise sinner jojo megapass martini assfuck ffffff mushroom jamaica 7654321 77777 cccccc gizmodo
+tractor mypass hongkong 1975 blue123 pissing thomas1 redred basketball satan666 dublin bollox
+kingkong 1971 22222 272727 sexx bbbb grizzly passat defiant bowler knickers monitor wisdom slappy
+thor letsgo robert1 brownie 098765 playtime lightnin atomic goku llllll qwaszx cosmos bosco knights
+beast slapshot assword frosty dumbass mallard dddd 159357 titleist aussie golfing doobie loveit
+werewolf vipers 1965 blabla surf sucking tardis thegame legion rebels sarah1 onelove loulou toto
+blackcat 0007 tacobell soccer1 jedi method poopie boob breast kittycat belly pikachu thunder1
+thankyou celtics frogger scoobydo sabbath coltrane budman jackal zzzzz licking gopher geheim
+lonestar primus pooper newpass brasil heather1 husker element moomoo beefcake zzzzzzzz shitty
+smokin jjjj anthony1 anubis backup gorilla fuckface lowrider punkrock traffic delta1 amazon fatass
+dodgeram dingdong qqqqqqqq breasts boots honda1 spidey poker temp johnjohn 147852 asshole1 dogdog
+tricky crusader syracuse spankme speaker meridian amadeus harley1 falcons turkey50 kenwood keyboard
+ilovesex 1978 shazam shalom lickit jimbob roller fatman sandiego magnus cooldude clover mobile
+plumber texas1 tool topper mariners rebel caliente celica oxford osiris orgasm punkin porsche9
+tuesday breeze bossman kangaroo latinas astros scruffy qwertyu hearts jammer java 1122 goodtime
+chelsea1 freckles flyboy doodle nebraska bootie kicker webmaster vulcan 191919 blueeyes 321321
+farside rugby director pussy69 power1 hershey hermes monopoly birdman blessed blackjac southern
+peterpan thumbs fuckyou1 rrrrrr a1b2c3d4 coke bohica elvis1 blacky sentinel snake1 richard1
+1234abcd guardian candyman fisting scarlet dildo pancho mandingo lucky7 condom munchkin billyboy
+summer1 sword skiing site sony thong rootbeer assassin fffff fitness durango postal achilles kisses
+warriors plymouth topdog asterix hallo cameltoe fuckfuck eeeeee sithlord theking avenger backdoor
+chevrole trance cosworth houses homers eternity kingpin verbatim incubus 1961 blond zaphod shiloh
+spurs mighty aliens charly dogman omega1 printer aggies deadhead bitch1 stone55 pineappl thekid
+rockets camels formula oracle pussey porkchop abcde clancy mystic inferno blackdog steve1 alfa
+grumpy flames puffy proxy valhalla unreal herbie engage yyyyyy 010101 pistol celeb gggg portugal
+a12345 newbie mmmm 1qazxsw2 zorro writer stripper sebastia spread links metal 1221 565656 funfun
+trojans cyber hurrican moneys 1x2zkg8w zeus tomato lion atlantic usa123 trans aaaaaaa homerun
+hyperion kevin1 blacks 44444444 skittles fart gangbang fubar sailboat oilers buster1 hithere
+immortal sticks pilot lexmark jerkoff maryland cheers possum cutter muppet swordfish sport sonic
+peter1 jethro rockon asdfghj pass123 pornos ncc1701a bootys buttman bonjour 1960 bears 362436
+spartans tinman threesom maxmax 1414 bbbbb camelot chewie gogo fusion saint dilligaf nopass hustler
+hunter1 whitey beast1 yesyes spank smudge pinkfloy patriot lespaul hammers formula1 sausage
+scooter1 orioles oscar1 colombia cramps exotic iguana suckers slave topcat lancelot magelan racer
+crunch british steph 456123 skinny seeking rockhard filter freaks sakura pacman poontang newlife
+homer1 klingon watcher walleye tasty sinatra starship steel starbuck poncho amber1 gonzo catherin
+candle firefly goblin scotch diver usmc huskies kentucky kitkat beckham bicycle yourmom studio
+33333333 splash jimmy1 12344321 sapphire mailman raiders1 ddddd excalibu illini imperial lansing
+maxx gothic golfball facial front242 macdaddy qwer1234 vectra cowboys1 crazy1 dannyboy aquarius
+franky ffff sassy pppp pppppppp prodigy noodle eatpussy vortex wanking billy1 siemens phillies
+groups chevy1 cccc gggggggg doughboy dracula nurses loco lollipop utopia chrono cooler nevada
+wibble summit 1225 capone fugazi panda qazwsxed puppies triton 9876 nnnnnn momoney iforgot wolfie
+studly hamburg 81fukkc 741852 catman china gagging scott1 oregon qweqwe crazybab daniel1 cutlass
+holes mothers music1 walrus 1957 bigtime xtreme simba ssss rookie bathing rotten maestro turbo1
+99999 butthole hhhh yoda shania phish thecat rightnow baddog greatone gateway1 abstr napster brian1
+bogart hitler wildfire jackson1 1981 beaner yoyo 0.0.0.000 super1 select snuggles slutty phoenix1
+technics toon raven1 rayray 123789 1066 albion greens gesperrt brucelee hehehe kelly1 mojo 1998
+bikini woofwoof yyyy strap sites central f**k nyjets punisher username vanilla twisted bunghole
+viagra veritas pony titts labtec jenny1 masterbate mayhem redbull govols gremlin 505050 gmoney
+rovers diamond1 trident abnormal deskjet cuddles bristol milano vh5150 jarhead 1982 bigbird bizkit
+sixers slider star69 starfish penetration tommy1 john316 caligula flicks films railroad cosmo
+cthulhu br0d3r bearbear swedish spawn patrick1 reds anarchy groove fuckher oooo airbus cobra1 clips
+delete duster kitty1 mouse1 monkeys jazzman 1919 262626 swinging stroke stocks sting pippen
+labrador jordan1 justdoit meatball females vector cooter defender nike bubbas bonkers kahuna
+wildman 4121 sirius static piercing terror teenage leelee microsof mechanic robotech rated chaser
+salsero macross quantum tsunami daddy1 cruise newpass6 nudes hellyeah 1959 zaq12wsx striker spice
+spectrum smegma thumb jjjjjjjj mellow cancun cartoon sabres samiam oranges oklahoma lust denali
+nude noodles brest hooter mmmmmmmm warthog blueblue zappa wolverine sniffing jjjjj calico freee
+rover pooter closeup bonsai emily1 keystone iiii 1955 yzerman theboss tolkien megaman rasta
+bbbbbbbb hal9000 goofy gringo gofish gizmo1 samsam scuba onlyme tttttttt corrado clown clapton
+bulls jayhawk wwww sharky seeker ssssssss pillow thesims lighter lkjhgf melissa1 marcius2 guiness
+gymnast casey1 goalie godsmack lolo rangers1 poppy clemson clipper deeznuts holly1 eeee kingston
+yosemite sucked sex123 sexy69 pic's tommyboy masterbating gretzky happyday frisco orchid orange1
+manchest aberdeen ne1469 boxing korn intercourse 161616 1985 ziggy supersta stoney amature babyboy
+bcfields goliath hack hardrock frodo scout scrappy qazqaz tracker active craving commando cohiba
+cyclone bubba69 katie1 mpegs vsegda irish1 sexy1 smelly squerting lions jokers jojojo meathead
+ashley1 groucho cheetah champ firefox gandalf1 packer love69 tyler1 typhoon tundra bobby1 kenworth
+village volley wolf359 0420 000007 swimmer skydive smokes peugeot pompey legolas redhot rodman
+redalert grapes 4runner carrera floppy ou8122 quattro cloud9 davids nofear busty homemade mmmmm
+whisper vermont webmaste wives insertion jayjay philips topher temptress midget ripken havefun
+canon celebrity ghetto ragnarok usnavy conover cruiser dalshe nicole1 buzzard hottest kingfish
+misfit milfnew warlord wassup bigsexy blackhaw zippy tights kungfu labia meatloaf area51 batman1
+bananas 636363 ggggg paradox queens adults aikido cigars hoosier eeyore moose1 warez interacial
+streaming 313131 pertinant pool6123 mayday animated banker baddest gordon24 ccccc fantasies aisan
+deadman homepage ejaculation whocares iscool jamesbon 1956 1pussy womam sweden skidoo spock sssss
+pepper1 pinhead micron allsop amsterda gunnar 666999 february fletch george1 sapper sasha1 luckydog
+lover1 magick popopo ultima cypress businessbabe brandon1 vulva vvvv jabroni bigbear yummy 010203
+searay secret1 sinbad sexxxx soleil software piccolo thirteen leopard legacy memorex redwing
+rasputin 134679 anfield greenbay catcat feather scanner pa55word contortionist danzig daisy1 hores
+exodus iiiiii 1001 subway snapple sneakers sonyfuck picks poodle test1234 llll junebug marker
+mellon ronaldo roadkill amanda1 asdfjkl beaches great1 cheerleaers doitnow ozzy boxster brighton
+housewifes kkkk mnbvcx moocow vides 1717 bigmoney blonds 1000 storys stereo 4545 420247 seductive
+sexygirl lesbean justin1 124578 cabbage canadian gangbanged dodge1 dimas malaka puss probes coolman
+nacked hotpussy erotica kool implants intruder bigass zenith woohoo womans tango pisces laguna
+maxell andyod22 barcelon chainsaw chickens flash1 orgasms magicman profit pusyy pothead coconut
+chuckie clevelan builder budweise hotshot horizon experienced mondeo wifes 1962 stumpy smiths
+slacker pitchers passwords laptop allmine alliance bbbbbbb asscock halflife 88888 chacha saratoga
+sandy1 doogie qwert40 transexual close-up ib6ub9 volvo jacob1 iiiii beastie sunnyday stoned sonics
+starfire snapon pictuers pepe testing1 tiberius lisalisa lesbain litle retard ripple austin1
+badgirl golfgolf flounder royals dragoon dickie passwor majestic poppop trailers nokia bobobo br549
+minime mikemike whitesox 1954 3232 353535 seamus solo sluttey pictere titten lback 1024 goodluck
+fingerig gallaries goat passme oasis lockerroom logan1 rainman treasure custom cyclops nipper
+bucket homepage- hhhhh momsuck indain 2345 beerbeer bimmer stunner 456456 tootsie testerer reefer
+1012 harcore gollum 545454 chico caveman fordf150 fishes gaymen saleen doodoo pa55w0rd presto qqqqq
+cigar bogey helloo dutch kamikaze wasser vietnam visa japanees 0123 swords slapper peach
+masterbaiting redwood 1005 ametuer chiks fucing sadie1 panasoni mamas rambo unknown absolut dallas1
+housewife keywest kipper 18436572 1515 zxczxc 303030 shaman terrapin masturbation mick redfish 1492
+angus goirish hardcock forfun galary freeporn duchess olivier lotus pornographic ramses purdue
+traveler crave brando enter1 killme moneyman welder windsor wifey indon yyyyy taylor1 4417 picher
+pickup thumbnils johnboy jets ameteur amateurs apollo13 hambone goldwing 5050 sally1 doghouse
+padres pounding quest truelove underdog trader climber bolitas hohoho beanie beretta wrestlin
+stroker sexyman jewels johannes mets rhino bdsm balloons grils happy123 flamingo route66 devo
+outkast paintbal magpie llllllll twilight critter cupcake nickel bullseye knickerless videoes
+binladen xerxes slim slinky pinky thanatos meister menace retired albatros balloon goten 5551212
+getsdown donuts nwo4life tttt comet deer dddddddd deeznutz nasty1 nonono enterprise eeeee misfit99
+milkman vvvvvv 1818 blueboy bigbutt tech toolman juggalo jetski barefoot 50spanks gobears
+scandinavian cubbies nitram kings bilbo yumyum zzzzzzz stylus 321654 shannon1 server squash starman
+steeler phrases techniques laser 135790 athens cbr600 chemical fester gangsta fucku2 droopy objects
+passwd lllll manchester vedder clit chunky darkman buckshot buddah boobed henti winter1 bigmike
+beta zidane talon slave1 pissoff thegreat lexus matador readers armani goldstar 5656 fmale fuking
+fucku ggggggg sauron diggler pacers looser pounded premier triangle cosmic depeche norway helmet
+mustard misty1 jagger 3x7pxr silver1 snowboar penetrating photoes lesbens lindros roadking rockford
+1357 143143 asasas goodboy 898989 chicago1 ferrari1 galeries godfathe gawker gargoyle gangster
+rubble rrrr onetime pussyman pooppoop trapper cinder newcastl boricua bunny1 boxer hotred hockey1
+edward1 moscow mortgage bigtit snoopdog joshua1 july 1230 assholes frisky sanity divine dharma
+lucky13 akira butterfly hotbox hootie howdy earthlink kiteboy westwood 1988 blackbir biggles wrench
+wrestle slippery pheonix penny1 pianoman thedude jenn jonjon jones1 roadrunn arrow azzer seahawks
+diehard dotcom tunafish chivas cinnamon clouds deluxe northern boobie momomo modles volume 23232323
+bluedog wwwwwww zerocool yousuck pluto limewire joung awnyce gonavy haha films+pic+galeries girsl
+fuckthis girfriend uncencored a123456 chrisbln combat cygnus cupoi netscape hhhhhhhh eagles1 elite
+knockers 1958 tazmania shonuf pharmacy thedog midway arsenal1 anaconda australi gromit gotohell
+787878 66666 carmex2 camber gator1 ginger1 fuzzy seadoo lovesex rancid uuuuuu 911911 bulldog1
+heater monalisa mmmmmmm whiteout virtual jamie1 japanes james007 2727 2469 blam bitchass zephyr
+stiffy sweet1 southpar spectre tigger1 tekken lakota lionking jjjjjjj megatron 1369 hawaiian
+gymnastic golfer1 gunners 7779311 515151 sanfran optimus panther1 love1 maggie1 pudding aaron1
+delphi niceass bounce house1 killer1 momo musashi jammin 2003 234567 wp2003wp submit sssssss spikes
+sleeper passwort kume meme medusa mantis reebok 1017 artemis harry1 cafc91 fettish oceans oooooooo
+mango ppppp trainer uuuu 909090 death1 bullfrog hokies holyshit eeeeeee jasmine1 &amp &amp; spinner
+jockey babyblue gooner 474747 cheeks pass1234 parola okokok poseidon 989898 crusher cubswin nnnn
+kotaku mittens whatsup vvvvv iomega insertions bengals biit yellow1 012345 spike1 sowhat pitures
+pecker theend hayabusa hawkeyes florian qaz123 usarmy twinkle chuckles hounddog hover hothot europa
+kenshin kojak mikey1 water1 196969 wraith zebra wwwww 33333 simon1 spider1 snuffy philippe thunderb
+teddy1 marino13 maria1 redline renault aloha handyman cerberus gamecock gobucks freesex duffman
+ooooo nuggets magician longbow preacher porno1 chrysler contains dalejr navy buffy1 hedgehog
+hoosiers honey1 hott heyhey dutchess everest wareagle ihateyou sunflowe 3434 senators shag spoon
+sonoma stalker poochie terminal terefon maradona 1007 142536 alibaba america1 bartman astro goth
+chicken1 cheater ghost1 passpass oral r2d2c3po civic cicero myxworld kkkkk missouri wishbone
+infiniti 1a2b3c 1qwerty wonderboy shojou sparky1 smeghead poiuy titanium lantern jelly 1213 bayern
+basset gsxr750 cattle fishing1 fullmoon gilles dima obelix popo prissy ramrod bummer hotone dynasty
+entry konyor missy1 282828 xyz123 426hemi 404040 seinfeld pingpong lazarus marine1 12345a beamer
+babyface greece gustav 7007 ccccccc faggot foxy gladiato duckie dogfood packers1 longjohn radical
+tuna clarinet danny1 novell bonbon kashmir kiki mortimer modelsne moondog vladimir insert 1953
+zxc123 supreme 3131 sexxx softail poipoi pong mars martin1 rogue avalanch audia4 55bgates cccccccc
+came11 figaro dogboy dnsadm dipshit paradigm othello operator tripod chopin coucou cocksuck
+borussia heritage hiziad homerj mullet whisky 4242 speedo starcraf skylar spaceman piggy tiger2
+legos jezebel joker1 mazda 727272 chester1 rrrrrrrr dundee lumber ppppppp tranny aaliyah admiral
+comics delight buttfuck homeboy eternal kilroy violin wingman walmart bigblue blaze beemer beowulf
+bigfish yyyyyyy woodie yeahbaby 0123456 tbone syzygy starter linda1 merlot mexican 11235813 banner
+bangbang badman barfly grease charles1 ffffffff doberman dogshit overkill coolguy claymore demo
+nomore hhhhhhh hondas iamgod enterme electron eastside minimoni mybaby wildbill wildcard ipswich
+200000 bearcat zigzag yyyyyyyy sweetnes 369369 skyler skywalker pigeon tipper asdf123 alphabet
+asdzxc babybaby banane guyver graphics chinook florida1 flexible fuckinside ursitesux tototo adam12
+christma chrome buddie bombers hippie misfits 292929 woofer wwwwwwww stubby sheep sparta stang spud
+sporty pinball just4fun maxxxx rebecca1 fffffff freeway garion rrrrr sancho outback maggot puddin
+987456 hoops mydick 19691969 bigcat shiner silverad templar lamer juicy mike1 maximum 1223 10101010
+arrows alucard haggis cheech safari dog123 orion1 paloma qwerasdf presiden vegitto 969696 adonis
+cookie1 newyork1 buddyboy hellos heineken eraser moritz millwall visual jaybird 1983 beautifu
+zodiac steven1 sinister slammer smashing slick1 sponge teddybea ticklish jonny 1211 aptiva applepie
+bailey1 guitar1 canyon gagged fuckme1 digital1 dinosaur 98765 90210 clowns cubs deejay nigga naruto
+boxcar icehouse hotties electra widget 1986 2004 bluefish bingo1 ***** stratus sultan storm1 44444
+4200 sentnece sexyboy sigma smokie spam pippo temppass manman 1022 bacchus aztnm axio bamboo hakr
+gregor hahahaha 5678 camero1 dolphin1 paddle magnet qwert1 pyon porsche1 tripper noway burrito bozo
+highheel hookem eddie1 entropy kkkkkkkk kkkkkkk illinois 1945 1951 24680 21212121 100000 stonecold
+taco subzero sexxxy skolko skyhawk spurs1 sputnik testpass jiggaman 1224 hannah1 525252 4ever
+carbon scorpio1 rt6ytere madison1 loki coolness coldbeer citadel monarch morgan1 washingt 1997
+bella1 yaya superb taxman studman 3636 pizzas tiffany1 lassie larry1 joseph1 mephisto reptile razor
+1013 hammer1 gypsy grande camper chippy cat123 chimera fiesta glock domain dieter dragonba onetwo
+nygiants password2 quartz prowler prophet towers ultra cocker corleone dakota1 cumm nnnnnnn boxers
+heynow iceberg kittykat wasabi vikings1 beerman splinter snoopy1 pipeline mickey1 mermaid micro
+meowmeow redbird baura chevys caravan frogman diving dogger draven drifter oatmeal paris1 longdong
+quant4307s rachel1 vegitta cobras corsair dadada mylife bowwow hotrats eastwood moonligh modena
+illusion iiiiiii jayhawks swingers shocker shrimp sexgod squall poiu tigers1 toejam tickler julie1
+jimbo1 jefferso michael2 rodeo robot 1023 annie1 bball happy2 charter flasher falcon1 fiction
+fastball gadget scrabble diaper dirtbike oliver1 paco macman poopy popper postman ttttttt acura
+cowboy1 conan daewoo nemrac58 nnnnn nextel bobdylan eureka kimmie kcj9wx5n killbill musica volkswag
+wage windmill wert vintage iloveyou1 itsme zippo 311311 starligh smokey1 snappy soulmate plasma
+krusty just4me marius rebel1 1123 audi fick goaway rusty2 dogbone doofus ooooooo oblivion mankind
+mahler lllllll pumper puck pulsar valkyrie tupac compass concorde cougars delaware niceguy nocturne
+bob123 boating bronze herewego hewlett houhou earnhard eeeeeeee mingus mobydick venture verizon
+imation 1950 1948 1949 223344 bigbig wowwow sissy spiker snooker sluggo player1 jsbach jumbo medic
+reddevil reckless 123456a 1125 1031 astra gumby 757575 585858 chillin fuck1 radiohea upyours trek
+coolcool classics choochoo nikki1 nitro boytoy excite kirsty wingnut wireless icu812 1master beatle
+bigblock wolfen summer99 sugar1 tartar sexysexy senna sexman soprano platypus pixies telephon
+laura1 laurent rimmer 1020 12qwaszx hamish halifax fishhead forum dododo doit paramedi lonesome
+mandy1 uuuuu uranus ttttt bruce1 helper hopeful eduard dusty1 kathy1 moonbeam muscles monster1
+monkeybo windsurf vvvvvvv vivid install 1947 187187 1941 1952 susan1 31415926 sinned sexxy smoothie
+snowflak playstat playa playboy1 toaster jerry1 marie1 mason1 merlin1 roger1 roadster 112358 1121
+andrea1 bacardi hardware 789789 5555555 captain1 fergus sascha rrrrrrr dome onion lololo qqqqqqq
+undertak uuuuuuuu uuuuuuu cobain cindy1 coors descent nimbus nomad nanook norwich bombay broker
+hookup kiwi winners jackpot 1a2b3c4d 1776 beardog bighead bird33 0987 spooge pelican peepee titan
+thedoors jeremy1 altima baba hardone 5454 catwoman finance farmboy farscape genesis1 salomon loser1
+r2d2 pumpkins chriss cumcum ninjas ninja1 killers miller1 islander jamesbond intel 19841984 2626
+bizzare blue12 biker yoyoma sushi shitface spanker steffi sphinx please1 paulie pistons tiburon
+maxwell1 mdogg rockies armstron alejandr arctic banger audio asimov 753951 4you chilly care1839
+flyfish fantasia freefall sandrine oreo ohshit macbeth madcat loveya qwerqwer colnago chocha cobalt
+crystal1 dabears nevets nineinch broncos1 epsilon kestrel winston1 warrior1 iiiiiiii iloveyou2 1616
+woowoo sloppy specialk tinkerbe jellybea reader redsox1 1215 1112 arcadia baggio 555666 cayman
+cbr900rr gabriell glennwei sausages disco pass1 lovebug macmac puffin vanguard trinitro airwolf
+aaa111 cocaine cisco datsun bricks bumper eldorado kidrock wizard1 whiskers wildwood istheman
+25802580 bigones woodland wolfpac strawber 3030 sheba1 sixpack peace1 physics tigger2 toad megan1
+meow ringo amsterdam 717171 686868 5424 canuck football1 footjob fulham seagull orgy lobo mancity
+vancouve vauxhall acidburn derf myspace1 boozer buttercu hola minemine munch 1dragon biology
+bestbuy bigpoppa blackout blowfish bmw325 bigbob stream talisman tazz sundevil 3333333 skate shutup
+shanghai spencer1 slowhand pinky1 tootie thecrow jubilee jingle matrix1 manowar messiah resident
+redbaron romans andromed athlon beach1 badgers guitars harald harddick gotribe 6996 7grout 5wr2i7h8
+635241 chase1 fallout fiddle fenris francesc fortuna fairlane felix1 gasman fucks sahara sassy1
+dogpound dogbert divx1 manila pornporn quasar venom 987987 access1 clippers daman crusty nathan1
+nnnnnnnn bruno1 budapest kittens kerouac mother1 waldo1 whistler whatwhat wanderer idontkno 1942
+1946 bigdawg bigpimp zaqwsx 414141 3000gt 434343 serpent smurf pasword thisisit john1 robotics
+redeye rebelz 1011 alatam asians bama banzai harvest 575757 5329 fatty fender1 flower2 funky sambo
+drummer1 dogcat oedipus osama prozac private1 rampage concord cinema cornwall cleaner ciccio clutch
+corvet07 daemon bruiser boiler hjkl egghead mordor jamess iverson3 bluesman zouzou 090909 1002
+stone1 4040 sexo smith1 sperma sneaky polska thewho terminat krypton lekker johnson1 johann rockie
+aspire goodie cheese1 fenway fishon fishin fuckoff1 girls1 doomsday pornking ramones rabbits
+transit aaaaa1 boyz bookworm bongo bunnies buceta highbury henry1 eastern mischief mopar ministry
+vienna wildone bigbooty beavis1 xxxxxx1 yogibear 000001 0815 zulu 420000 sigmar sprout stalin
+lkjhgfds lagnaf rolex redfox referee 123123123 1231 angus1 ballin attila greedy grunt 747474
+carpedie caramel foxylady gatorade futbol frosch saiyan drums donner doggy1 drum doudou nutmeg
+quebec valdepen tosser tuscl comein cola deadpool bremen hotass hotmail1 eskimo eggman koko kieran
+katrin kordell1 komodo mone munich vvvvvvvv jackson5 2222222 bergkamp bigben zanzibar xxx123 sunny1
+373737 slayer1 snoop peachy thecure little1 jennaj rasta69 1114 aries havana gratis calgary
+checkers flanker salope dirty1 draco dogface luv2epus rainbow6 qwerty123 umpire turnip vbnm tucson
+troll codered commande neon nico nightwin boomer1 bushido hotmail0 enternow keepout karen1 mnbv
+viewsoni volcom wizards 1995 berkeley woodstoc tarpon shinobi starstar phat toolbox julien johnny1
+joebob riders reflex 120676 1235 angelus anthrax atlas grandam harlem hawaii50 655321 cabron
+challeng callisto firewall firefire flyer flower1 gambler frodo1 sam123 scania dingo papito
+passmast ou8123 randy1 twiggy travis1 treetop addict admin1 963852 aceace cirrus bobdole bonjovi
+bootsy boater elway7 kenny1 moonshin montag wayne1 white1 jazzy jakejake 1994 1991 2828 bluejays
+belmont sensei southpark peeper pharao pigpen tomahawk teensex leedsutd jeepster jimjim josephin
+melons matthias robocop 1003 1027 antelope azsxdc gordo hazard granada 8989 7894 ceasar cabernet
+cheshire chelle candy1 fergie fidelio giorgio fuckhead dominion qawsed trucking chloe1 daddyo
+nostromo boyboy booster bucky honolulu esquire dynamite mollydog windows1 waffle wealth vincent1
+jabber jaguars javelin irishman idefix bigdog1 blue42 blanked blue32 biteme1 bearcats yessir
+sylveste sunfire tbird stryker 3ip76k2 sevens pilgrim tenchi titman leeds lithium linkin marijuan
+mariner markie midnite reddwarf 1129 123asd 12312312 allstar albany asdf12 aspen hardball goldfing
+7734 49ers carnage callum carlos1 fitter fandango gofast gamma fucmy69 scrapper dogwood django
+magneto premium 9999999 abc1234 newyear bookie bounty brown1 bologna elway killjoy klondike mouser
+wayer impreza insomnia 24682468 2580 24242424 billbill bellaco blues1 blunts teaser sf49ers shovel
+solitude spikey pimpdadd timeout toffee lefty johndoe johndeer mega manolo ratman robin1 1124 1210
+1028 1226 babylove barbados gramma 646464 carpente chaos1 fishbone fireblad frogs screamer scuba1
+ducks doggies dicky obsidian rams tottenham aikman comanche corolla cumslut cyborg boston1 houdini
+helmut elvisp keksa12 monty1 wetter watford wiseguy 1989 1987 20202020 biatch beezer bigguns
+blueball bitchy wyoming yankees2 wrestler stupid1 sealteam sidekick simple1 smackdow sporting
+spiral smeller plato tophat test2 toomuch jello junkie maxim maxime meadow remingto roofer 124038
+1018 1269 1227 123457 arkansas aramis beaker barcelona baltimor googoo goochi 852456 4711 catcher
+champ1 fortress fishfish firefigh geezer rsalinas samuel1 saigon scooby1 dick1 doom dontknow
+magpies manfred vader1 universa tulips mygirl bowtie holycow honeys enforcer waterboy 1992 23skidoo
+bimbo blue11 birddog zildjian 030303 stinker stoppedby sexybabe speakers slugger spotty smoke1
+polopolo perfect1 torpedo lakeside jimmys junior1 masamune 1214 april1 grinch 767676 5252 cherries
+chipmunk cezer121 carnival capecod finder fearless goats funstuff gideon savior seabee sandro
+schalke salasana disney1 duckman pancake pantera1 malice love123 qwert123 tracer creation cwoui
+nascar24 hookers erection ericsson edthom kokoko kokomo mooses inter 1michael 1993 19781978
+25252525 shibby shamus skibum sheepdog sex69 spliff slipper spoons spanner snowbird toriamos
+temp123 tennesse lakers1 jomama mazdarx7 recon revolver 1025 1101 barney1 babycake gotham gravity
+hallowee 616161 515000 caca cannabis chilli fdsa getout fuck69 gators1 sable rumble dolemite dork
+duffer dodgers1 onions logger lookout magic32 poon twat coventry citroen civicsi cocksucker coochie
+compaq1 nancy1 buzzer boulder butkus bungle hogtied hotgirls heidi1 eggplant mustang6 monkey12
+wapapapa wendy1 volleyba vibrate blink birthday4 xxxxx1 stephen1 suburban sheeba start1 soccer10
+starcraft soccer12 peanut1 plastics penthous peterbil tetsuo torino tennis1 termite lemmein
+lakewood jughead melrose megane redone angela1 goodgirl gonzo1 golden1 gotyoass 656565 626262
+capricor chains calvin1 getmoney gabber runaway salami dungeon dudedude opus paragon panhead
+pasadena opendoor odyssey magellan printing prince1 trustme nono buffet hound kajak killkill moto
+winner1 vixen whiteboy versace voyager1 indy jackjack bigal beech biggun blake1 blue99 big1 synergy
+success1 336699 sixty9 shark1 simba1 sebring spongebo spunk springs sliver phialpha password9
+pizza1 pookey
+`
+
+//英文常用词,按词频排序
+const englishList = `
+you i to the a and that it of me what is in this know for no have my just not do be on your was we
+with so but all well are he oh about right get here out going like yeah if her she can up want
+think now go him at how got there one did why see come good they really as would look when time
+will okay back mean tell from hey were could yes his been or something who because some had then
+say ok take an way us little make need gonna never too sure them more over our sorry where let
+thing am maybe down man has uh very by should anything said much any life even off doing thank give
+only thought help two talk people god still wait into find nothing again things call told great
+before better ever night than away first believe other feel everything work fine home after last
+these day keep does put around stop guy always listen wanted mr guys huh those big lot happened
+thanks trying kind wrong through talking made new being guess hi care bad mom remember getting
+together dad leave place understand actually hear baby nice father else stay done their course
+might mind every enough try hell came someone own family whole another house yourself idea ask best
+must coming old looking woman which years room left knew tonight real son hope name same went um
+hmm happy pretty saw girl sir show friend already saying next three job problem minute found world
+thinking heard honey matter myself exactly having ah probably happen hurt boy both while dead gotta
+alone since excuse start kill hard today car ready until without wants hold wanna yet seen deal
+took once gone called morning supposed friends head stuff most used worry second part live truth
+school face forget true business each cause soon knows few telling wife use chance run move anyone
+person bye somebody dr heart such miss married point later making meet anyway many phone reason
+damn lost looks bring case turn wish tomorrow kids trust check change end late anymore five least
+town ha working year makes taking means brother play hate ago says beautiful gave fact crazy party
+sit open afraid between important rest fun kid word watch glad everyone days sister minutes
+everybody bit couple whoa either mrs feeling daughter wow gets asked under break promise door set
+close hand easy question tried far walk needs mine though times different killed hospital anybody
+alright wedding shut able die perfect stand comes hit story ya mm waiting dinner against funny
+husband almost pay answer four office eyes news child half side yours moment sleep read started men
+sounds sonny pick sometimes em bed also date line plan hours lose hands serious behind inside high
+ahead week wonderful fight past cut quite number sick game eat nobody goes along save seems finally
+lives worried upset carly met book brought seem sort safe living children leaving front shot loved
+asking running clear figure hot felt six parents drink absolutely daddy alive sense meant happens
+special bet blood kidding lie full meeting dear seeing sound fault water ten women buy months hour
+speak lady jen thinks christmas body order outside hang possible worse company mistake ooh handle
+spend totally giving control marriage realize president unless sex send needed taken died scared
+picture talked ass hundred changed completely explain playing certainly sign boys relationship
+loves hair lying choice anywhere future weird luck turned known touch kiss crane questions
+obviously wonder pain calling somewhere throw straight cold fast words food none drive feelings
+worked marry light drop cannot sent city dream protect twenty class surprise its sweetheart poor
+looked mad except gun dance takes appreciate especially situation besides pull himself act worth
+sheridan amazing top given expect rather involved swear piece busy law decided happening movie
+catch country less perhaps step fall watching kept darling dog win air honor personal moving till
+admit problems murder evil definitely feels information honest eye broke missed longer dollars
+tired evening human starting red entire trip club niles suppose calm imagine fair caught blame
+street sitting favor apartment court terrible clean learn works frasier relax million accident wake
+prove smart message missing forgot interested table nbsp become mouth pregnant middle ring careful
+shall team ride figured wear shoot stick follow angry instead write stopped early ran war standing
+forgive jail wearing kinda lunch cristian eight greenlee gotten hoping phoebe thousand ridge paper
+tough tape state count boyfriend proud agree birthday seven history share offer hurry feet
+wondering decision building ones finish voice herself list mess deserve evidence cute dress
+interesting hotel quiet concerned road staying beat sweetie mention clothes finished fell neither
+mmm fix respect spent prison attention holding calls near surprised bar keeping gift putting dark
+self owe using ice helping normal aunt lawyer apart certain plans jax girlfriend floor whether
+present earth box cover judge upstairs sake mommy possibly worst station acting accept blow strange
+saved conversation plane mama yesterday lied quick lately stuck report difference rid store bag
+bought doubt listening walking cops deep dangerous buffy sleeping chloe rafe shh record lord moved
+join card crime gentlemen willing window return walked guilty likes fighting difficult soul joke
+favorite uncle promised public bother island seriously cell lead knowing broken advice somehow paid
+losing push helped killing usually earlier boss beginning liked innocent doc rules cop learned
+thirty risk letting speaking officer ridiculous support afternoon born apologize seat nervous
+across song charge patient boat hide detective planning nine huge breakfast horrible age awful
+pleasure driving hanging picked sell quit apparently dying notice congratulations chief month visit
+letter decide double sad press forward fool showed smell seemed spell memory pictures slow seconds
+hungry board position hearing roz kitchen force fly during space realized experience kick others
+grab discuss third cat fifty responsible fat reading idiot yep suddenly agent destroy bucks track
+shoes scene peace arms demon low livvie consider papers medical incredible witch drunk attorney
+tells knock ways gives department nose skye turns keeps jealous drug sooner cares plenty extra tea
+won attack ground whose outta weekend matters wrote type gosh opportunity impossible books waste
+pretend named jump eating proof complete slept career arrest breathe perfectly warm pulled twice
+easier goin dating suit romantic drugs comfortable finds checked fit divorce begin ourselves closer
+ruin although smile laugh treat fear otherwise excited mail hiding cost stole pacey noticed fired
+excellent lived bringing pop bottom note sudden bathroom flight honestly sing foot games remind
+bank charges witness finding places tree dare hardly interest steal silly contact teach shop plus
+colonel fresh trial invited roll radio reach heh choose emergency dropped credit obvious cry locked
+loving positive nuts agreed prue goodbye condition guard fuckin grow cake mood total crap crying
+belong lay partner trick pressure ohh arm dressed cup lies bus taste neck south nurse raise lots
+carry group whoever drinking breaking file lock wine closed writing spot paying study assume asleep
+turning legal viki bedroom shower nikolas camera fill reasons forty bigger nope breath doctors
+pants level movies gee area folks ugh continue focus wild truly desk convince client threw band
+hurts spending allow grand answers shirt chair allowed rough doin sees government ought empty round
+hat wind shows aware dealing pack meaning hurting ship subject guest pal match arrested salem
+confused surgery expecting deacon unfortunately goddamn lab passed bottle beyond whenever pool
+opinion held common starts jerk secrets falling played necessary barely dancing health tests copy
+cousin planned dry ahem twelve simply tess skin often fifteen speech names issue orders nah final
+results code believed complicated umm research nowhere escape biggest restaurant grateful usual
+burn address within someplace screw everywhere train film regret goodness mistakes details
+responsibility suspect corner hero dumb terrific further gas whoo hole memories following ended
+teeth ruined split airport bite stenbeck older liar showing project cards desperate themselves
+pathetic damage spoke quickly scare marah afford vote settle mentioned due stayed rule checking tie
+hired upon heads concern blew natural alcazar champagne connection tickets happiness form saving
+kissing hated personally suggest prepared build leg onto leaves downstairs ticket taught loose holy
+staff sea duty convinced throwing defense kissed legs according loud practice saturday babies army
+warning miracle carrying flying blind ugly shopping hates sight bride coat account states clearly
+celebrate brilliant wanting add forrester lips custody center screwed buying size toast thoughts
+student stories however professional reality birth lexie attitude advantage grandfather sami sold
+opened grandma beg changes someday grade roof brothers signed ahh marrying powerful grown
+grandmother fake opening expected eventually ideas exciting covered familiar bomb bout television
+harmony color heavy schedule records capable practically including correct clue forgotten
+immediately appointment social nature deserves threat bloody lonely ordered shame local jacket hook
+destroyed scary investigation above invite shooting port lesson criminal growing caused victim
+professor followed funeral considering burning strength loss view gia sisters several pushed
+written shock pushing heat chocolate greatest miserable corinthos nightmare brings zander character
+became famous enemy crash chances sending recognize healthy boring feed engaged percent headed
+lines treated purpose knife rights drag san fan badly hire paint pardon built behavior closet warn
+gorgeous milk survive forced operation offered ends dump rent remembered lieutenant trade
+thanksgiving rain revenge physical available program prefer spare pray disappeared aside statement
+sometime meat fantastic breathing laughing itself tip stood market affair ours depends main
+protecting jury national brave large interview fingers murdered explanation process picking based
+style pieces blah assistant stronger aah pie handsome unbelievable anytime nearly shake oakdale
+cars wherever serve pulling points medicine facts waited lousy circumstances stage disappointed
+weak trusted license nothin community trash understanding slip cab sounded awake friendship stomach
+weapon threatened mystery official regular river vegas understood contract race basically switch
+frankly issues cheap lifetime deny painting ear clock weight garbage tear ears dig selling setting
+indeed changing singing tiny particular draw decent avoid messed filled touched score disappear
+exact pills kicked harm recently fortune pretending raised insurance fancy drove cared belongs
+nights shape lorelai base lift stock fashion timing guarantee chest bridge woke source patients
+theory original burned watched heading selfish oil drinks failed period doll committed elevator
+freeze noise exist science pair edge wasting sat ceremony pig uncomfortable peg guns staring files
+bike weather mostly stress permission arrived thrown possibility example borrow release ate notes
+hoo library property negative fabulous event doors screaming xander term meal fellow apology anger
+honeymoon wet bail parking non protection fixed families chinese campaign map wash stolen sensitive
+stealing chose lets comfort worrying whom pocket mateo bleeding students shoulder ignore fourth
+neighborhood fbi talent tied garage dies demons dumped witches training rude crack model bothering
+radar grew remain soft meantime gimme connected kinds cast sky likely fate buried hug concentrate
+prom messages east unit intend crew ashamed somethin manage guilt weapons terms interrupt guts
+tongue distance conference treatment shoe basement sentence purse glasses cabin universe towards
+repeat mirror wound travers tall reaction odd engagement therapy letters emotional runs magazine
+jeez decisions soup thrilled society managed stake chef moves extremely entirely moments expensive
+counting shots kidnapped square cleaning shift plate impressed smells trapped male tour aidan
+knocked charming attractive argue puts whip language embarrassed settled package laid animals
+hitting disease bust stairs alarm pure nail nerve incredibly walks dirt stamp becoming terribly
+friendly easily damned jobs suffering disgusting stopping deliver riding helps federal disaster
+bars dna crossed rate create trap claim california talks eggs effect chick threatening spoken
+introduce confession embarrassing bags impression gate reputation attacked among knowledge presents
+inn europe chat suffer argument talkin crowd homework fought coincidence cancel accepted rip pride
+solve hopefully pounds pine mate illegal generous streets con separate outfit maid bath punch mayor
+freaked begging recall enjoying bug prepare parts wheel signal direction defend signs painful
+yourselves rat maris amount suspicious flat cooking button warned sixty pity parties crisis coach
+row yelling leads awhile pen confidence offering falls image farm pleased panic hers gettin role
+refuse determined grandpa progress testify passing military choices uhh gym cruel wings bodies
+mental gentleman coma cutting proteus guests expert benefit faces cases led jumped toilet secretary
+sneak mix firm halloween agreement privacy dates anniversary smoking reminds pot created twins
+swing successful season scream considered solid options commitment senior ill crush ambulance
+wallet discovered officially til rise reached eleven option laundry former assure stays skip fail
+accused wide challenge popular learning discussion clinic plant exchange betrayed bro sticking
+university members lower bored mansion soda sheriff suite handled busted senator load happier
+younger studying romance procedure ocean section sec commit assignment suicide minds swim ending
+bat yell llanview league chasing seats proper command believes humor hopes fifth winning solution
+leader sale lawyers nor material latest highly escaped audience parent tricks insist dropping cheer
+medication higher flesh district routine century shared sandwich handed false beating appear
+warrant awfully odds article treating thin suggesting fever sweat silent specific clever sweater
+request prize mall tries mile fully estate union sharing assuming judgment goodnight divorced
+despite surely steps jet confess math listened comin answered vulnerable bless dreaming rooms chip
+zero potential pissed nate kills tears knees chill brains agency harvard degree unusual joint
+packed dreamed cure covering newspaper lookin coast grave egg direct cheating breaks quarter mixed
+locker gifts awkward toy thursday rare policy joking competition classes assumed reasonable dozen
+curse quartermaine millions dessert rolling detail alien served delicious closing vampires released
+ancient wore value tail secure salad murderer hits toward spit screen offense dust conscience bread
+answering admitted lame invitation grief smiling path stands bowl pregnancy hollywood prisoner
+delivery guards virus shrink influence freezing concert wreck partners massimo chain birds wire
+technically presence blown anxious cave version holidays cleared wishes survived caring candles
+bound related charm yup pulse jumping jokes frame boom vice performance occasion silence opera
+nonsense frightened downtown americans slipped dimera blowing session relationships kidnapping
+actual spin civil roxy packing education blaming wrap obsessed fruit torture personality location
+effort commander trees owner fairy per necessarily county contest seventy print motel fallen
+directly underwear grams exhausted believing particularly freaking carefully trace touching messing
+committee recovery intention consequences belt sacrifice courage officers enjoyed lack attracted
+appears bay yard returned remove nut carried testimony intense granted violence heal defending
+attempt unfair relieved political loyal approach slowly plays normally buzz alcohol actor surprises
+psychiatrist pre plain attic uniform terrified sons pet cleaned zach threaten teaching mum motion
+fella enemies desert collection incident failure satisfied imagination hooked headache forgetting
+counselor andie acted opposite highest equipment badge italian visiting naturally frozen
+commissioner sakes labor appropriate trunk armed thousands received dunno costume temporary sixteen
+impressive zone kicking junk hon grabbed unlike understands describe clients owns affect witnesses
+starving instincts happily discussing deserved strangers leading intelligence host authority
+surveillance cow commercial admire questioning fund dragged barn object deeply amp wrapped wasted
+tense route reports hoped fellas election roommate mortal fascinating chosen stops shown arranged
+abandoned sides delivered becomes arrangements agenda began theater series literally propose
+honesty underneath forces services sauce promises lecture eighty torn shocked relief explained
+counter circle victims transfer response channel identity differently campus spy ninety interests
+guide deck biological pheebs ease creep waitress skills telephone ripped raising scratch rings
+prints wave thee arguing figures ephram asks reception pin oops diner annoying agents taggert goal
+mass ability sergeant international gig blast basic tradition towel earned rub habit customers
+creature bermuda actions snap react prime paranoid wha handling eaten therapist comment charged tax
+sink reporter beats priority interrupting gain fed warehouse shy pattern loyalty inspector events
+pleasant media excuses threats permanent guessing financial demand assault tend praying motive los
+unconscious trained museum tracks range nap mysterious unhappy tone switched rappaport award sookie
+neighbor loaded gut childhood causing swore piss hundreds balance background toss mob misery thief
+squeeze lobby hah geez exercise ego drama forth facing booked boo songs sandburg eighteen bury
+perform everyday digging creepy compared wondered trail liver hmmm drawn device magical journey
+fits discussed supply moral helpful attached searching flew depressed aisle underground pro
+daughters cris amen vows proposal pit neighbors darn cents arrange annulment uses useless squad
+represent product joined afterwards adventure resist protected net fourteen celebrating piano inch
+flag debt violent tag sand gum dammit hip celebration below reminded claims replace phones
+paperwork emotions typical stubborn stable pound papa lap designed current bum tension tank
+suffered steady provide overnight meanwhile chips beef wins suits boxes salt cassadine collect
+tragedy therefore spoil realm profile degrees wipe surgeon stretch stepped nephew neat limo
+confident anti perspective designer climb title suggested punishment finest springfield occurred
+hint furniture blanket twist surrounded surface proceed lip fries worries refused niece gloves soap
+signature disappoint crawl convicted zoo result pages lit flip counsel doubts crimes accusing
+shaking remembering phase hallway halfway bothered useful makeup madam gather concerns cia cameras
+blackmail symptoms rope ordinary imagined concept cigarette supportive memorial explosion yay woo
+trauma ouch furious cheat avoiding whew thick oooh boarding approve urgent shhh misunderstanding
+minister drawer sin phony joining jam interfere governor chapter catching bargain tragic schools
+respond punish penthouse hop thou remains rach ohhh insult bugs beside begged absolute strictly
+stefano socks senses ups sneaking yah serving reward polite checks tale physically instructions
+fooled blows tabby internal bitter adorable tested suggestion string jewelry debate com alike pitch
+fax distracted shelter lessons foreign average twin damnit constable circus audition tune shoulders
+mud mask helpless feeding explains dated robbery objection behave valuable shadows courtroom
+confusing tub talented struck smarter mistaken italy customer bizarre scaring punk motherfucker
+holds focused alert activity vecchio reverend highway foolish compliment bastards attend scheme aid
+worker wheelchair protective poetry gentle script reverse picnic knee intended construction cage
+wednesday voices toes stink scares pour effects cheated tower slide ruining recent jewish filling
+exit cottage corporate upside supplies proves parked instance grounds diary complaining basis
+wounded politics confessed pipe merely massage data chop budget brief spill prayer costs betray
+begins arrangement waiter scam rats fraud flu brush adopted tables sympathy pill pee web seventeen
+landed expression entrance employee drawing cap bracelet principal pays fairly facility dru deeper
+arrive unique tracking spite shed recommend oughta nanny naive menu grades diet corn authorities
+separated roses patch dime devastated description tap subtle include citizen bullets beans ric pile
+las executive confirm toe strings parade harbor bow borrowed toys straighten steak status remote
+premonition poem planted honored youth specifically meetings exam convenient traveling matches
+laying insisted apply units technology dish aitoro sis kindly grandson donor temper teenager
+strategy proven iron denial couples backwards tent swell noon happiest episode drives thinkin
+spirits potion fence affairs acts whatsoever rehearsal proved overheard nuclear lemme hostage faced
+constant bench tryin taxi shove sets moron limits impress entitled needle limit lad intelligent
+instant forms disagree stinks rianna recover losers groom gesture developed constantly blocks
+bartender tunnel suspects sealed removed legally illness hears dresses aye vehicle thy teachers
+sheet receive psychic denied knocking judging bible behalf accidentally waking ton superior seek
+rumor manners homeless hollow desperately critical theme tapes referring personnel item genoa gear
+majesty fans exposed cried tons spells producer launch instinct belief quote motorcycle convincing
+appeal advance greater fashioned aids accomplished grip bump upsetting soldiers scheduled
+production needing invisible forgiveness feds complex compare bothers tooth territory sacred mon
+inviting inner earn compromise cocktail tramp temperature signing landing jabot intimate dignity
+dealt souls informed gods entertainment dressing cigarettes blessing billion alistair upper manner
+lightning leak fond corky alternative seduce players operate modern liquor fingerprints enchantment
+butters stuffed stavros rome filed emotionally division conditions uhm transplant tips passes
+oxygen nicely lunatic hid drill designs complain announcement visitors unfortunate slap prayers
+plug organization opens oath mutual graduate confirmed broad yacht spa remembers fried
+extraordinary bait appearance abuse warton sworn stare safely reunion plot burst aha experiment
+dive commission cells aboard returning independent expose environment buddies trusting smaller
+mountains booze sweep sore scudder properly parole manhattan effective ditch decides canceled bra
+speaks spanish reaching glow foundation wears thirsty skull ringing dorm dining bend unexpected
+systems sob pancakes harsh flattered existence ahhh troubles proposed fights favourite eats driven
+computers rage causes border undercover spoiled sloane shine rug identify destroying deputy
+deliberately conspiracy clothing thoughtful similar sandwiches plates nails miracles investment
+fridge drank contrary beloved allergic washed stalking solved sack misses forgiven cuz bent
+approval practical organized maciver involve industry fuel dragging cooked possession pointing foul
+editor dull beneath ages horror heels grass faking deaf stunt portrait painted jealousy hopeless
+fears cuts conclusion volunteer scenario satellite necklace crashed chapel accuse restraining
+humans homicide helicopter formal firing shortly safer devoted auction videotape tore stores
+reservations pops appetite wounds vanquish symbol prevent patrol ironic flow fathers excitement
+anyhow tearing sends rape laughed function core charmed sub dealer cooperate bachelor accomplish
+wakes struggle spotted sorts reservation ashes yards votes tastes supposedly loft intentions
+integrity wished towels suspected slightly qualified log investigating inappropriate immediate
+companies backed pan owned lipstick lawn compassion cafeteria belonged affected scarf precisely
+obsession management loses lighten infection granddaughter explode chemistry balcony storage spying
+publicity exists employees depend cue cracked conscious aww ally ace accounts absurd vicious tools
+strongly rap invented forbid directions defendant bare announce screwing salesman robbed leap
+lakeview insanity injury genetic document reveal religious possibilities kidnap gown entering
+chairs wishing statue setup serial punished dramatic dismissed criminals seventh regrets raped
+quarters produce lamp dentist anyways anonymous added semester risks regarding owes magazines
+machines lungs explaining delicate tricked oldest liv eager doomed cafe bureau adoption traditional
+surrender stab sickness scum loop independence generation floating envelope entered combination
+chamber worn vault sorel pretended potatoes plea photograph payback misunderstood kiddo healing
+cascade capeside application stabbed remarkable cabinet brat wrestling sixth scale privilege
+passionate nerves lawsuit kidney disturbed crossing cozy associate tire shirts required posted oven
+ordering mill journal gallery delay clubs risky nest monsters honorable grounded favour culture
+closest breakdown attempted placed conflict bald actress abandon steam scar pole duh collar
+worthless standards resources photographs introduced injured graduation enormous disturbing disturb
+distract deals conclusions vodka situations require mid measure dishes crawling congress briefcase
+wiped whistle sits roast rented pigs greek flirting existed deposit damaged bottles types topic
+riot overreacting minimum logical impact hostile embarrass casual beacon amusing altar values
+recognized maintain goods covers claus battery survival skirt shave prisoners porch med ghosts
+favors drops dizzy chili begun beaten advise transferred strikes rehab raw photographer peaceful
+leery heavens fortunately fooling expectations draft citizens weakness ski ships ranch practicing
+musical movement individual homes executed examine documents cranes column bribe task species sail
+rum resort prescription operating hush fragile forensics expense drugged differences cows conduct
+comic bells avenue attacking assigned visitor suitcase sources sorta scan payment motor mini
+manticore inspired insecure imagining hardest clerk yea wrist tube starters silk pump pale nicer
+haul flies demands boot arts african limited elders connections quietly pulls idiots factor erase
+denying attacks ankle amnesia accepting ooo heartbeat gal devane confront backing phrase operations
+minus meets legitimate hurricane fixing communication boats auto arrogant supper studies slightest
+sins sayin recipe pier paternity humiliating genuine catholic snack rational pointed minded guessed
+display dip advanced weddings unh tumor teams reported humiliated destruction copies closely bid
+aspirin academy wig throughout spray occur logic eyed equal drowning contacts shakespeare ritual
+perfume hiring hating generally error elected docks creatures visions thanking thankful sock
+replaced nineteen fork comedy analysis yale throws teenagers studied stressed slice rolls requires
+plead ladder kicks detectives assured widow tissue tellin shallow responsibilities repay rejected
+permanently girlfriends deadly comforting ceiling bonus verdict maintenance jar insensitive factory
+aim triple spilled respected recovered messy interrupted halliwell bleed benefits wardrobe takin
+significant objective murders doo chart backs workers waves underestimate ties registered multiple
+justify harmless frustrated fold enzo convention communicate bugging attraction arson whack salary
+rumors residence obligation medium liking development develop dearest congratulate vengeance
+switzerland severe rack puzzle puerto guidance fires courtesy caller blamed tops repair quiz prep
+involves headquarters curiosity codes circles barbecue troops sunnydale spinning scores pursue
+psychotic cough claimed accusations shares resent laughs gathered freshman envy drown bartlet asses
+sofa scientist poster islands highness dock apologies welfare theirs stat stall spots somewhat
+realizes psych fools finishing album wee understandable unable treats theatre succeed stir relaxed
+makin inches gratitude faithful bin accent zip witter wandering regardless que locate inevitable
+gretel deed crushed controlling taxes smelled settlement robe poet opposed marked gossip gambling
+determine cuba cosmetics cent accidents surprising stiff sincere shield rushed resume reporting
+refrigerator reference preparing nightmares mijo ignoring hunch fog fireworks drowned crown
+cooperation brass accurate whispering sophisticated religion luggage investigate hike explore
+emotion creek crashing contacted complications ceo acid shining rolled righteous reconsider
+inspiration goody geek frightening festival ethics creeps courthouse camping assistance affection
+vow smythe protest lodge haircut forcing essay chairman baked apologized vibe respects receipt mami
+includes hats exclusive destructive define defeat adore adopt voted tracked signals shorts
+reminding relative ninth floors dough creations continues cancelled cabot barrel snuck slight
+reporters rear pressing novel newspapers magnificent madame lazy glorious fiancee candidate brick
+bits australia activities visitation scholarship sane previous kindness shoulda rescued mattress
+lounge lifted label importantly glove enterprises disappointment condo cemetery beings admitting
+yelled waving screech satisfaction requested reads plants nun nailed described dedicated
+certificate centuries annual worm tick resting primary polish marvelous fuss funds defensive
+cortlandt compete chased provided pockets luckily lilith filing depression conversations
+consideration consciousness worlds innocence indicate forehead bam appeared aggressive trailer slam
+retirement quitting pry narrow levels inform encourage dug delighted daylight danced currently
+confidential aunts washing vic tossed spectra permit marrow lined implying hatred grill efforts
+corpse clues sober relatives promotion offended morgue larger infected humanity eww electricity
+electrical distraction cart broadcast wired violation suspended promising harassment glue gathering
+cursed controlled calendar brutal assets warlocks wagon unpleasant proving priorities observation
+lease grows flame domestic disappearance depressing thrill sitter ribs offers naw flush exception
+earrings deadline corporal collapsed update snapped smack orleans offices melt figuring delusional
+coulda burnt actors trips tender sperm specialist scientific realise pork popped planes kev
+interrogation institution included esteem communications choosing choir undo pres prayed plague
+manipulate lifestyle insulting honour detention delightful coffeehouse chess betrayal apologizing
+adjust wrecked wont whipped rides reminder psychological principle monsieur injuries fame faint
+confusion bon bake nearest korea industries execution distress definition creating correctly
+complaint blocked trophy tortured structure rot risking pointless household heir handing eighth
+dumping cups alibi absence vital tokyo thus struggling shiny risked refer mummy mint involvement
+hose hobby fortunate fleischman fitting curtain counseling addition wit transport technical rode
+puppet opportunities modeling memo irresponsible humiliation hiya freakin fez felony choke
+blackmailing appreciated tabloid suspicion recovering rally psychology pledge panicked nursery
+louder jeans investigator identified homecoming height graduated frustrating fabric distant buys
+busting buff wax sleeve products philosophy irony hospitals dope declare autopsy workin torch
+substitute scandal prick limb leaf hysterical growth goddamnit fetch dimension crowded clip
+climbing bonding approved yeh woah ultimately trusts returns negotiate millennium majority lethal
+length iced deeds bore babysitter questioned outrageous medal kiriakis insulted grudge established
+driveway deserted definite capture beep wires suggestions searched owed originally nickname
+lighting lend drunken demanding costanza conviction characters bumped weigh touches tempted shout
+resolve relate poisoned pip occasionally meals maker invitations haunted fur footage depending
+bogus autograph affects tolerate stepping spontaneous sleeps probation presentation performed manny
+identical fist cycle associates streak spectacular sector lasted increase hostages heroin havin
+habits encouraging cult consult burgers boyfriends bailed baggage association wealthy watches
+versus troubled torturing teasing sweetest stations sip rag qualities postpone pad overwhelmed
+malkovich impulse hut follows classy charging amazed scenes rising revealed representing policeman
+offensive mug hypocrite humiliate hideous finals experiences courts costumes captured bluffing
+betting bein bedtime alcoholic vegetable tray suspicions spreading splendid shouting roots pressed
+nooo jew intent grieving gladly fling eliminate disorder cereal arrives aaah yum technique
+statements sonofabitch servant roads republican paralyzed orb lotta locks guaranteed european dummy
+discipline despise dental corporation carries briefing bluff batteries atmosphere whatta tux
+sounding servants rifle presume handwriting goals gin fainted elements dried cape allright allowing
+acknowledge whacked toxic skating reliable quicker penalty panel overwhelming nearby lining
+importance harassing fatal endless elsewhere dolls convict bold ballet whatcha unlikely spiritual
+shutting separation recording positively overcome goddam failing essence dose diagnosis cured
+claiming bully airline ahold yearbook various tempting shelf rig pursuit prosecution pouring
+possessed partnership countries wonders tsk thorough spine rath psychiatric meaningless latte
+jammed ignored fiance exposure exhibit evidently duties contempt compromised capacity cans weekends
+urge theft suing shipment scissors responding refuses proposition noises matching located ink
+hormones hiv hail grandchildren godfather gently establish contracts compound worldwide smashed
+sexually sentimental senor scored nicest marketing manipulated jaw intern handcuffs framed errands
+entertaining discovery crib carriage barge awards attending ambassador videos tab spends slipping
+seated rubbing rely reject recommendation reckon ratings headaches float embrace corners whining
+sweating sole skipped restore receiving population pep mountie motives listens korean heroes
+cristobel controls cheerleader balsom unnecessary stunning shipping scent quartermaines praise pose
+montega luxury loosen info hum haunt gracious git forgiving fleet errand emperor cakes blames
+abortion worship theories strict sketch shifts plotting physician perimeter passage pals mere
+mattered lonigan longest jews interference eyewitness enthusiasm encounter diapers artists
+strongest shaken serves punched projects portal outer nazi colleagues catches bearing backyard
+academic winds terrorists sabotage pea organs needy mentor measures listed lex cuff civilization
+caribbean articles writes woof valid rarely rabbi prank performing obnoxious mates improve hereby
+gabby faked cellar whitelighter void substance strangle sour skill senate purchase native muffins
+interfering hoh demonic colored clearing civilian buildings boutique barrington trading terrace
+smoked seed righty relations quack published preliminary petey pact outstanding opinions knot
+ketchup items examined disappearing cordy coin circuit assist administration walt uptight ticking
+terrifying tease syd swamp secretly rejection reflection realizing rays pennsylvania partly
+mentally marone jurisdiction doubted deception crucial congressman cheesy arrival visited
+supporting stalling scouts scoop ribbon reserve raid notion income immune expects edition destined
+constitution classroom bets appreciation appointed accomplice wander shoved sewer scroll retire
+paintings lasts fugitive freezer discount cranky crank clearance bodyguard anxiety accountant
+whoops volunteered terrorist tales talents stinking resolved remotely protocol garlic decency cord
+beds areas altogether uniforms tremendous restaurants rank profession popping philadelphia outa
+observe lung largest hangs feelin experts enforcement encouraged economy dudes donation disguise
+curb continued competitive businessman bites antique advertising ads toothbrush
+`
+
+//英文男性名
+const maleNamesList = `
+james john robert michael william david richard charles joseph thomas christopher daniel paul mark
+donald george kenneth steven edward brian ronald anthony kevin jason matthew gary timothy jose
+larry jeffrey frank scott eric stephen andrew raymond gregory joshua jerry dennis walter patrick
+peter harold douglas henry carl arthur ryan roger joe juan jack albert jonathan justin terry gerald
+keith samuel willie ralph lawrence nicholas roy benjamin bruce brandon adam harry fred wayne billy
+steve louis jeremy aaron randy eugene carlos russell bobby victor ernest phillip todd jesse craig
+alan shawn clarence sean philip chris johnny earl jimmy antonio danny bryan tony luis mike stanley
+leonard nathan dale manuel rodney curtis norman marvin vincent glenn jeffery travis jeff chad jacob
+melvin alfred kyle francis bradley jesus herbert frederick ray joel edwin don eddie ricky troy
+randall barry bernard mario leroy francisco marcus micheal theodore clifford miguel oscar jay jim
+tom calvin alex jon ronnie bill lloyd tommy leon derek darrell jerome floyd leo alvin tim wesley
+dean greg jorge dustin pedro derrick dan zachary corey herman maurice vernon roberto clyde glen
+hector shane ricardo sam rick lester brent ramon tyler gilbert gene marc reginald ruben brett angel
+nathaniel rafael edgar milton raul ben cecil duane andre elmer brad gabriel ron roland jared adrian
+karl cory claude erik darryl neil christian javier fernando clinton ted mathew tyrone darren lonnie
+lance cody julio kurt allan clayton hugh max dwayne dwight armando felix jimmie everett ian ken bob
+jaime casey alfredo alberto dave ivan johnnie sidney byron julian isaac clifton willard daryl
+virgil andy salvador kirk sergio seth kent terrance rene eduardo terrence enrique freddie stuart
+fredrick arturo alejandro joey nick luther wendell jeremiah evan julius donnie otis trevor luke
+homer gerard doug kenny hubert angelo shaun lyle matt alfonso orlando rex carlton ernesto pablo
+lorenzo omar wilbur blake horace roderick kerry abraham rickey ira andres cesar johnathan malcolm
+rudolph damon kelvin rudy preston alton archie marco wm pete randolph garry geoffrey jonathon
+felipe bennie gerardo ed dominic loren delbert colin guillermo earnest benny noel rodolfo myron
+edmund salvatore cedric lowell gregg sherman devin sylvester roosevelt israel jermaine forrest
+wilbert leland simon irving owen rufus woodrow kristopher levi marcos gustavo lionel marty gilberto
+clint nicolas laurence ismael orville drew ervin dewey al wilfred josh hugo ignacio caleb tomas
+sheldon erick frankie darrel rogelio terence alonzo elias bert elbert ramiro conrad noah grady phil
+cornelius lamar rolando clay percy dexter bradford merle darin amos terrell moses irvin saul roman
+darnell randal tommie timmy darrin brendan toby van abel dominick emilio elijah cary domingo aubrey
+emmett marlon emanuel jerald edmond emil dewayne otto teddy reynaldo bret jess trent humberto
+emmanuel stephan louie vicente lamont garland micah efrain heath rodger demetrius ethan eldon rocky
+pierre eli bryce antoine robbie kendall royce sterling grover elton cleveland dylan chuck damian
+reuben stan leonardo russel erwin benito hans monte blaine ernie curt quentin agustin jamal devon
+adolfo tyson wilfredo bart jarrod vance denis damien joaquin harlan desmond elliot darwin gregorio
+kermit roscoe esteban anton solomon norbert elvin nolan carey rod quinton hal brain rob elwood
+kendrick darius moises marlin fidel thaddeus cliff marcel ali raphael bryon armand alvaro jeffry
+dane joesph thurman ned sammie rusty michel monty rory fabian reggie kris isaiah gus avery loyd
+diego adolph millard rocco gonzalo derick rodrigo gerry rigoberto alphonso ty rickie noe vern elvis
+bernardo mauricio hiram donovan basil nickolas scot vince quincy eddy sebastian federico ulysses
+heriberto donnell denny gavin emery romeo jayson dion dante clement coy odell jarvis bruno issac
+dudley sanford colby carmelo nestor hollis stefan donny art linwood beau weldon galen isidro truman
+delmar johnathon silas frederic irwin merrill charley marcelino carlo trenton kurtis aurelio
+winfred vito collin denver leonel emory pasquale mohammad mariano danial landon dirk branden adan
+numbers clair buford german bernie wilmer emerson zachery jacques errol josue edwardo wilford
+theron raymundo daren tristan robby lincoln jame genaro octavio cornell hung arron antony herschel
+alva giovanni garth cyrus cyril ronny stevie lon kennith carmine augustine erich chadwick wilburn
+russ myles jonas mitchel mervin zane jamel lazaro alphonse randell major johnie jarrett ariel abdul
+dusty luciano seymour scottie eugenio mohammed valentin arnulfo lucien ferdinand thad ezra aldo
+rubin royal mitch earle abe marquis lanny kareem jamar boris isiah emile elmo aron leopoldo
+everette josef eloy dorian rodrick reinaldo lucio jerrod weston hershel lemuel lavern burt jules
+gil eliseo ahmad nigel efren antwan alden margarito refugio dino osvaldo les deandre normand kieth
+ivory trey norberto napoleon jerold fritz rosendo milford sang deon christoper alfonzo lyman josiah
+brant wilton rico jamaal dewitt brenton yong olin faustino claudio judson gino edgardo alec jarred
+donn trinidad tad porfirio odis lenard chauncey tod mel marcelo kory augustus keven hilario bud sal
+orval mauro dannie zachariah olen anibal milo jed thanh amado lenny tory richie horacio brice
+mohamed delmer dario mac jonah jerrold robt hank sung rupert rolland kenton damion chi antone waldo
+fredric bradly kip burl tyree jefferey ahmed willy stanford oren moshe mikel enoch brendon quintin
+jamison florencio darrick tobias minh hassan giuseppe demarcus cletus tyrell lyndon keenan werner
+theo geraldo columbus chet bertram markus huey hilton dwain donte tyron omer isaias hipolito fermin
+chung adalberto jamey teodoro mckinley maximo sol raleigh lawerence abram rashad emmitt daron chong
+samual otha miquel eusebio dong domenic darron wilber renato hoyt haywood ezekiel chas florentino
+elroy clemente arden neville edison deshawn carrol shayne nathanial jordon danilo claud val
+sherwood raymon rayford cristobal ambrose titus hyman felton ezequiel erasmo lonny len ike milan
+lino jarod herb andreas rhett jude douglass cordell oswaldo ellsworth virgilio toney nathanael del
+benedict mose hong isreal garret fausto asa arlen zack modesto francesco manual jae gaylord gaston
+filiberto deangelo michale granville wes malik zackary tuan nicky cristopher antione malcom korey
+jospeh colton waylon von hosea shad santo rudolf rolf rey renaldo marcellus lucius kristofer
+harland arnoldo rueben leandro kraig jerrell jeromy hobert cedrick arlie winford wally luigi keneth
+jacinto graig franklyn edmundo sid leif jeramy willian vincenzo shon michal lynwood jere hai
+`
+
+//英文女性名
+const femaleNamesList = `
+mary patricia linda barbara elizabeth jennifer maria susan margaret dorothy lisa nancy karen betty
+helen sandra donna carol ruth sharon michelle laura sarah kimberly deborah jessica shirley cynthia
+angela melissa brenda amy anna rebecca virginia kathleen pamela martha debra amanda stephanie
+carolyn christine marie janet catherine frances ann joyce diane alice julie heather teresa doris
+gloria evelyn jean cheryl mildred katherine joan ashley judith rose janice kelly nicole judy
+christina kathy theresa beverly denise tammy irene jane lori rachel marilyn andrea kathryn louise
+sara anne jacqueline wanda bonnie julia ruby lois tina phyllis norma paula diana annie lillian
+emily robin peggy crystal gladys rita dawn connie florence tracy edna tiffany carmen rosa cindy
+grace wendy victoria edith kim sherry sylvia josephine thelma shannon sheila ethel ellen elaine
+marjorie carrie charlotte monica esther pauline emma juanita anita rhonda hazel amber eva debbie
+april leslie clara lucille jamie joanne eleanor valerie danielle megan alicia suzanne michele gail
+bertha darlene veronica jill erin geraldine lauren cathy joann lorraine lynn sally regina erica
+beatrice dolores bernice audrey yvonne annette june marion dana stacy ana renee ida vivian roberta
+holly brittany melanie loretta yolanda jeanette laurie katie kristen vanessa alma sue elsie beth
+jeanne vicki carla tara rosemary eileen terri gertrude lucy tonya ella stacey wilma gina kristin
+jessie natalie agnes vera charlene bessie delores melinda pearl arlene maureen colleen allison
+tamara joy georgia constance lillie claudia jackie marcia tanya nellie minnie marlene heidi glenda
+lydia viola courtney marian stella caroline dora jo vickie mattie maxine irma mabel marsha myrtle
+lena christy deanna patsy hilda gwendolyn jennie nora margie nina cassandra leah penny kay
+priscilla naomi carole olga billie dianne tracey leona jenny felicia sonia miriam velma becky
+bobbie violet kristina toni misty mae shelly daisy ramona sherri erika katrina claire lindsey
+lindsay geneva guadalupe belinda margarita sheryl cora faye ada natasha sabrina isabel marguerite
+hattie harriet molly cecilia kristi brandi blanche sandy rosie joanna iris eunice angie inez lynda
+madeline amelia alberta genevieve monique jodi janie kayla sonya jan kristine candace fannie
+maryann opal alison yvette melody luz susie olivia flora shelley kristy mamie lula lola verna
+beulah antoinette candice juana jeannette pam kelli whitney bridget karla celia latoya patty shelia
+gayle della vicky lynne sheri marianne kara jacquelyn erma blanca myra leticia pat krista roxanne
+angelica robyn adrienne rosalie alexandra brooke bethany sadie bernadette traci jody kendra nichole
+rachael mable ernestine muriel marcella elena krystal angelina nadine kari estelle dianna paulette
+lora mona doreen rosemarie desiree antonia janis betsy christie freda meredith lynette teri
+cristina eula leigh meghan sophia eloise rochelle gretchen cecelia raquel henrietta alyssa jana
+gwen jenna tricia laverne olive tasha silvia elvira delia kate patti lorena kellie sonja lila lana
+darla mindy essie mandy lorene elsa josefina jeannie miranda dixie lucia marta faith lela johanna
+shari camille tami shawna elisa ebony melba ora nettie tabitha ollie winifred kristie marina alisha
+aimee rena myrna marla tammie latasha bonita patrice ronda sherrie addie francine deloris stacie
+adriana cheri abigail celeste jewel cara adele rebekah lucinda dorthy effie trina reba sallie
+aurora lenora etta lottie kerri trisha nikki estella francisca josie tracie marissa karin brittney
+janelle lourdes laurel helene fern elva corinne kelsey ina bettie elisabeth aida caitlin ingrid iva
+eugenia christa goldie maude jenifer therese dena lorna janette latonya candy consuelo tamika
+rosetta debora cherie polly dina jewell fay jillian dorothea nell trudy esperanza patrica kimberley
+shanna helena cleo stefanie rosario ola janine mollie lupe alisa lou maribel susanne bette susana
+elise cecile isabelle lesley jocelyn paige joni rachelle leola daphne alta ester petra graciela
+imogene jolene keisha lacey glenna gabriela keri ursula lizzie kirsten shana adeline mayra jayne
+jaclyn gracie sondra carmela marisa rosalind charity tonia beatriz marisol clarice jeanine sheena
+angeline frieda lily shauna millie claudette cathleen angelia gabrielle autumn katharine jodie
+staci lea christi justine elma luella margret dominique socorro martina margo mavis callie bobbi
+maritza lucile leanne jeannine deana aileen lorie ladonna willa manuela gale selma dolly sybil abby
+ivy dee winnie marcy luisa jeri magdalena ofelia meagan audra matilda leila cornelia bianca simone
+bettye randi virgie latisha barbra georgina eliza leann bridgette rhoda haley adela nola bernadine
+flossie ila greta ruthie nelda minerva lilly terrie letha hilary estela valarie brianna rosalyn
+earline catalina ava mia clarissa lidia corrine alexandria concepcion tia sharron rae dona ericka
+jami elnora chandra lenore neva marylou melisa tabatha serena avis allie sofia jeanie odessa nannie
+harriett loraine penelope milagros emilia benita allyson ashlee tania esmeralda karina eve pearlie
+zelma malinda noreen tameka saundra hillary amie althea rosalinda lilia alana clare alejandra
+elinor lorrie jerri darcy earnestine carmella noemi marcie liza annabelle louisa earlene mallory
+carlene nita selena tanisha katy julianne lakisha edwina maricela margery kenya dollie roxie roslyn
+kathrine nanette charmaine lavonne ilene tammi suzette corine kaye chrystal lina deanne lilian
+juliana aline luann kasey maryanne evangeline colette melva lawanda yesenia nadia madge kathie
+ophelia valeria nona mitzi mari georgette claudine fran alissa roseann lakeisha susanna reva deidre
+chasity sheree elvia alyce deirdre gena briana araceli katelyn rosanne wendi tessa berta marva
+imelda marietta marci leonor arline sasha madelyn janna juliette deena aurelia josefa augusta
+liliana lessie amalia savannah anastasia vilma natalia rosella lynnette corina alfreda leanna
+amparo coleen tamra aisha wilda karyn queen maura mai evangelina rosanna hallie erna enid mariana
+lacy juliet jacklyn freida madeleine mara cathryn lelia casandra bridgett angelita jannie dionne
+annmarie katina beryl millicent katheryn diann carissa maryellen liz lauri helga gilda rhea
+marquita hollie tisha tamera angelique francesca kaitlin lolita florine rowena reyna twila fanny
+janell ines concetta bertie alba brigitte alyson vonda pansy elba noelle letitia deann brandie
+louella leta felecia sharlene lesa beverley isabella herminia terra celina tori octavia jade denice
+germaine michell cortney nelly doretha deidra monika lashonda judi chelsey antionette margot
+adelaide nan leeann elisha dessie libby kathi gayla latanya mina mellisa kimberlee jasmin renae
+zelda elda justina gussie emilie camilla abbie rocio kaitlyn edythe ashleigh selina lakesha geri
+allene pamala michaela dayna caryn rosalia sun jacquline rebeca marybeth krystle iola dottie belle
+griselda ernestina elida adrianne demetria delma jaqueline arleen virgina retha fatima tillie
+eleanore cari treva wilhelmina rosalee maurine latrice jena taryn elia debby maudie jeanna delilah
+catrina shonda hortencia theodora teresita robbin danette delphine brianne nilda danna cindi bess
+iona winona vida rosita marianna racheal guillermina eloisa celestine caren malissa lona chantel
+shellie marisela leora agatha soledad migdalia ivette christen janel veda pattie tessie tera
+marilynn lucretia karrie dinah daniela alecia adelina vernice shiela portia merry lashawn dara
+tawana oma verda alene zella sandi rafaela maya kira candida alvina suzan shayla lyn lettie samatha
+oralia matilde larissa vesta renita india delois shanda phillis lorri erlinda cathrine barb zoe
+isabell ione gisela roxanna mayme kisha ellie mellissa dorris dalia bella annetta zoila reta reina
+lauretta kylie christal pilar charla elissa tiffani tana paulina leota breanna jayme carmel vernell
+tomasa mandi dominga santa melodie lura alexa tamela mirna kerrie venus felicita cristy carmelita
+berniece annemarie tiara roseanne missy cori roxana pricilla kristal jung elyse haydee aletha
+bettina marge gillian filomena zenaida harriette caridad vada una aretha pearline marjory marcela
+flor evette elouise alina damaris catharine belva nakia marlena luanne lorine karon dorene danita
+brenna tatiana louann julianna andria philomena lucila leonora dovie romona mimi jacquelin gaye
+tonja misti chastity stacia roxann micaela nikita mei velda marlys johnna aura ivonne hayley nicki
+majorie herlinda yadira perla gregoria antonette shelli mozelle mariah joelle cordelia josette
+chiquita trista laquita georgiana candi shanon hildegard valentina stephany magda karol gabriella
+tiana roma richelle oleta jacque idella alaina suzanna jovita tosha nereida marlyn kyla delfina
+tena stephenie sabina nathalie marcelle gertie darleen thea sharonda shantel belen venessa rosalina
+ona genoveva clementine rosalba renate renata georgianna floy dorcas ariana tyra theda mariam juli
+jesica vikki verla roselyn melvina jannette ginny debrah corrie asia violeta myrtis latricia
+collette charleen anissa viviana twyla nedra latonia lan hellen fabiola annamarie adell sharyn
+chantal niki maud lizette lindy kia kesha jeana danelle charline chanel valorie lia dortha cristal
+leone leilani gerri debi andra keshia ima eulalia easter dulce natividad linnie kami georgie catina
+brook alda winnifred sharla ruthann meaghan magdalene lissette adelaida venita trena shirlene
+shameka elizebeth dian shanta latosha carlotta windy rosina mariann leisa jonnie dawna cathie
+astrid laureen janeen holli fawn vickey teressa shante rubye marcelina chanda terese scarlett
+marnie lulu lisette jeniffer elenor dorinda donita carman bernita altagracia aleta adrianna zoraida
+nicola lyndsey janina ami starla phylis phuong kyra charisse blanch sanjuanita rona nanci marilee
+maranda brigette sanjuana marita kassandra joycelyn felipa chelsie bonny mireya lorenza kyong
+ileana candelaria sherie lucie leatrice lakeshia gerda edie bambi marylin lavon hortense garnet
+evie tressa shayna lavina kyung jeanetta sherrill shara phyliss mittie anabel alesia thuy tawanda
+joanie tiffanie lashanda karissa enriqueta daria daniella corinna alanna abbey roxane roseanna
+magnolia lida joellen era coral carleen tresa peggie novella nila maybelle jenelle carina nova
+melina marquerite margarette josephina evonne cinthia albina toya tawnya sherita myriam lizabeth
+lise keely jenni giselle cheryle ardith ardis alesha adriane shaina linnea karolyn felisha dori
+darci artie armida
+`
+
+//汉语拼音常见姓氏
+const surnamesList = `
+wang li zhang liu chen yang huang zhao wu zhou xu sun ma zhu hu guo he gao lin luo zheng liang xie
+song tang han feng deng cao peng zeng xiao tian dong yuan pan yu jiang cai jia ding wei xue ye yan
+lu du shi dai xia zhong fu fang bai zou meng xiong qin qiu hou yin lei hao gong kong shao mao wan
+gu lai kang yi chang qiao duan tan ouyang situ shangguan zhuge
+`
+
+//生成口令短语的词表,EFF的diceware长词表(CC BY 3.0,署名见NOTICE),按骰子点数11111至66666排列
+const dicewareList = `
+abacus abdomen abdominal abide abiding ability ablaze able abnormal abrasion abrasive abreast
+abridge abroad abruptly absence absentee absently absinthe absolute absolve abstain abstract absurd
+accent acclaim acclimate accompany account accuracy accurate accustom acetone achiness aching acid
+acorn acquaint acquire acre acrobat acronym acting action activate activator active activism
+activist activity actress acts acutely acuteness aeration aerobics aerosol aerospace afar affair
+affected affecting affection affidavit affiliate affirm affix afflicted affluent afford affront
+aflame afloat aflutter afoot afraid afterglow afterlife aftermath aftermost afternoon aged ageless
+agency agenda agent aggregate aghast agile agility aging agnostic agonize agonizing agony agreeable
+agreeably agreed agreeing agreement aground ahead ahoy aide aids aim ajar alabaster alarm albatross
+album alfalfa algebra algorithm alias alibi alienable alienate aliens alike alive alkaline alkalize
+almanac almighty almost aloe aloft aloha alone alongside aloof alphabet alright although altitude
+alto aluminum alumni always amaretto amaze amazingly amber ambiance ambiguity ambiguous ambition
+ambitious ambulance ambush amendable amendment amends amenity amiable amicably amid amigo amino
+amiss ammonia ammonium amnesty amniotic among amount amperage ample amplifier amplify amply amuck
+amulet amusable amused amusement amuser amusing anaconda anaerobic anagram anatomist anatomy anchor
+anchovy ancient android anemia anemic aneurism anew angelfish angelic anger angled angler angles
+angling angrily angriness anguished angular animal animate animating animation animator anime
+animosity ankle annex annotate announcer annoying annually annuity anointer another answering
+antacid antarctic anteater antelope antennae anthem anthill anthology antibody antics antidote
+antihero antiquely antiques antiquity antirust antitoxic antitrust antiviral antivirus antler
+antonym antsy anvil anybody anyhow anymore anyone anyplace anything anytime anyway anywhere aorta
+apache apostle appealing appear appease appeasing appendage appendix appetite appetizer applaud
+applause apple appliance applicant applied apply appointee appraisal appraiser apprehend approach
+approval approve apricot april apron aptitude aptly aqua aqueduct arbitrary arbitrate ardently area
+arena arguable arguably argue arise armadillo armband armchair armed armful armhole arming armless
+armoire armored armory armrest army aroma arose around arousal arrange array arrest arrival arrive
+arrogance arrogant arson art ascend ascension ascent ascertain ashamed ashen ashes ashy aside askew
+asleep asparagus aspect aspirate aspire aspirin astonish astound astride astrology astronaut
+astronomy astute atlantic atlas atom atonable atop atrium atrocious atrophy attach attain attempt
+attendant attendee attention attentive attest attic attire attitude attractor attribute atypical
+auction audacious audacity audible audibly audience audio audition augmented august authentic
+author autism autistic autograph automaker automated automatic autopilot available avalanche avatar
+avenge avenging avenue average aversion avert aviation aviator avid avoid await awaken award aware
+awhile awkward awning awoke awry axis babble babbling babied baboon backache backboard backboned
+backdrop backed backer backfield backfire backhand backing backlands backlash backless backlight
+backlit backlog backpack backpedal backrest backroom backshift backside backslid backspace backspin
+backstab backstage backtalk backtrack backup backward backwash backwater backyard bacon bacteria
+bacterium badass badge badland badly badness baffle baffling bagel bagful baggage bagged baggie
+bagginess bagging baggy bagpipe baguette baked bakery bakeshop baking balance balancing balcony
+balmy balsamic bamboo banana banish banister banjo bankable bankbook banked banker banking banknote
+bankroll banner bannister banshee banter barbecue barbed barbell barber barcode barge bargraph
+barista baritone barley barmaid barman barn barometer barrack barracuda barrel barrette barricade
+barrier barstool bartender barterer bash basically basics basil basin basis basket batboy batch
+bath baton bats battalion battered battering battery batting battle bauble bazooka blabber bladder
+blade blah blame blaming blanching blandness blank blaspheme blasphemy blast blatancy blatantly
+blazer blazing bleach bleak bleep blemish blend bless blighted blimp bling blinked blinker blinking
+blinks blip blissful blitz blizzard bloated bloating blob blog bloomers blooming blooper blot
+blouse blubber bluff bluish blunderer blunt blurb blurred blurry blurt blush blustery boaster
+boastful boasting boat bobbed bobbing bobble bobcat bobsled bobtail bodacious body bogged boggle
+bogus boil bok bolster bolt bonanza bonded bonding bondless boned bonehead boneless bonelike boney
+bonfire bonnet bonsai bonus bony boogeyman boogieman book boondocks booted booth bootie booting
+bootlace bootleg boots boozy borax boring borough borrower borrowing boss botanical botanist botany
+botch both bottle bottling bottom bounce bouncing bouncy bounding boundless bountiful bovine boxcar
+boxer boxing boxlike boxy breach breath breeches breeching breeder breeding breeze breezy brethren
+brewery brewing briar bribe brick bride bridged brigade bright brilliant brim bring brink brisket
+briskly briskness bristle brittle broadband broadcast broaden broadly broadness broadside broadways
+broiler broiling broken broker bronchial bronco bronze bronzing brook broom brought browbeat
+brownnose browse browsing bruising brunch brunette brunt brush brussels brute brutishly bubble
+bubbling bubbly buccaneer bucked bucket buckle buckshot buckskin bucktooth buckwheat buddhism
+buddhist budding buddy budget buffalo buffed buffer buffing buffoon buggy bulb bulge bulginess
+bulgur bulk bulldog bulldozer bullfight bullfrog bullhorn bullion bullish bullpen bullring bullseye
+bullwhip bully bunch bundle bungee bunion bunkbed bunkhouse bunkmate bunny bunt busboy bush busily
+busload bust busybody buzz cabana cabbage cabbie cabdriver cable caboose cache cackle cacti cactus
+caddie caddy cadet cadillac cadmium cage cahoots cake calamari calamity calcium calculate calculus
+caliber calibrate calm caloric calorie calzone camcorder cameo camera camisole camper campfire
+camping campsite campus canal canary cancel candied candle candy cane canine canister cannabis
+canned canning cannon cannot canola canon canopener canopy canteen canyon capable capably capacity
+cape capillary capital capitol capped capricorn capsize capsule caption captivate captive captivity
+capture caramel carat caravan carbon cardboard carded cardiac cardigan cardinal cardstock carefully
+caregiver careless caress caretaker cargo caring carless carload carmaker carnage carnation
+carnival carnivore carol carpenter carpentry carpool carport carried carrot carrousel carry cartel
+cartload carton cartoon cartridge cartwheel carve carving carwash cascade case cash casing casino
+casket cassette casually casualty catacomb catalog catalyst catalyze catapult cataract catatonic
+catcall catchable catcher catching catchy caterer catering catfight catfish cathedral cathouse
+catlike catnap catnip catsup cattail cattishly cattle catty catwalk caucasian caucus causal
+causation cause causing cauterize caution cautious cavalier cavalry caviar cavity cedar celery
+celestial celibacy celibate celtic cement census ceramics ceremony certainly certainty certified
+certify cesarean cesspool chafe chaffing chain chair chalice challenge chamber chamomile champion
+chance change channel chant chaos chaperone chaplain chapped chaps chapter character charbroil
+charcoal charger charging chariot charity charm charred charter charting chase chasing chaste
+chastise chastity chatroom chatter chatting chatty cheating cheddar cheek cheer cheese cheesy chef
+chemicals chemist chemo cherisher cherub chess chest chevron chevy chewable chewer chewing chewy
+chief chihuahua childcare childhood childish childless childlike chili chill chimp chip chirping
+chirpy chitchat chivalry chive chloride chlorine choice chokehold choking chomp chooser choosing
+choosy chop chosen chowder chowtime chrome chubby chuck chug chummy chump chunk churn chute cider
+cilantro cinch cinema cinnamon circle circling circular circulate circus citable citadel citation
+citizen citric citrus city civic civil clad claim clambake clammy clamor clamp clamshell clang
+clanking clapped clapper clapping clarify clarinet clarity clash clasp class clatter clause
+clavicle claw clay clean clear cleat cleaver cleft clench clergyman clerical clerk clever clicker
+client climate climatic cling clinic clinking clip clique cloak clobber clock clone cloning
+closable closure clothes clothing cloud clover clubbed clubbing clubhouse clump clumsily clumsy
+clunky clustered clutch clutter coach coagulant coastal coaster coasting coastland coastline coat
+coauthor cobalt cobbler cobweb cocoa coconut cod coeditor coerce coexist coffee cofounder cognition
+cognitive cogwheel coherence coherent cohesive coil coke cola cold coleslaw coliseum collage
+collapse collar collected collector collide collie collision colonial colonist colonize colony
+colossal colt coma come comfort comfy comic coming comma commence commend comment commerce commode
+commodity commodore common commotion commute commuting compacted compacter compactly compactor
+companion company compare compel compile comply component composed composer composite compost
+composure compound compress comprised computer computing comrade concave conceal conceded concept
+concerned concert conch concierge concise conclude concrete concur condense condiment condition
+condone conducive conductor conduit cone confess confetti confidant confident confider confiding
+configure confined confining confirm conflict conform confound confront confused confusing
+confusion congenial congested congrats congress conical conjoined conjure conjuror connected
+connector consensus consent console consoling consonant constable constant constrain constrict
+construct consult consumer consuming contact container contempt contend contented contently
+contents contest context contort contour contrite control contusion convene convent copartner cope
+copied copier copilot coping copious copper copy coral cork cornball cornbread corncob cornea
+corned corner cornfield cornflake cornhusk cornmeal cornstalk corny coronary coroner corporal
+corporate corral correct corridor corrode corroding corrosive corsage corset cortex cosigner
+cosmetics cosmic cosmos cosponsor cost cottage cotton couch cough could countable countdown
+counting countless country county courier covenant cover coveted coveting coyness cozily coziness
+cozy crabbing crabgrass crablike crabmeat cradle cradling crafter craftily craftsman craftwork
+crafty cramp cranberry crane cranial cranium crank crate crave craving crawfish crawlers crawling
+crayfish crayon crazed crazily craziness crazy creamed creamer creamlike crease creasing creatable
+create creation creative creature credible credibly credit creed creme creole crepe crept crescent
+crested cresting crestless crevice crewless crewman crewmate crib cricket cried crier crimp crimson
+cringe cringing crinkle crinkly crisped crisping crisply crispness crispy criteria critter croak
+crock crook croon crop cross crouch crouton crowbar crowd crown crucial crudely crudeness cruelly
+cruelness cruelty crumb crummiest crummy crumpet crumpled cruncher crunching crunchy crusader
+crushable crushed crusher crushing crust crux crying cryptic crystal cubbyhole cube cubical cubicle
+cucumber cuddle cuddly cufflink culinary culminate culpable culprit cultivate cultural culture
+cupbearer cupcake cupid cupped cupping curable curator curdle cure curfew curing curled curler
+curliness curling curly curry curse cursive cursor curtain curtly curtsy curvature curve curvy
+cushy cusp cussed custard custodian custody customary customer customize customs cut cycle cyclic
+cycling cyclist cylinder cymbal cytoplasm cytoplast dab dad daffodil dagger daily daintily dainty
+dairy daisy dallying dance dancing dandelion dander dandruff dandy danger dangle dangling daredevil
+dares daringly darkened darkening darkish darkness darkroom darling darn dart darwinism dash
+dastardly data datebook dating daughter daunting dawdler dawn daybed daybreak daycare daydream
+daylight daylong dayroom daytime dazzler dazzling deacon deafening deafness dealer dealing
+dealmaker dealt dean debatable debate debating debit debrief debtless debtor debug debunk decade
+decaf decal decathlon decay deceased deceit deceiver deceiving december decency decent deception
+deceptive decibel decidable decimal decimeter decipher deck declared decline decode decompose
+decorated decorator decoy decrease decree dedicate dedicator deduce deduct deed deem deepen deeply
+deepness deface defacing defame default defeat defection defective defendant defender defense
+defensive deferral deferred defiance defiant defile defiling define definite deflate deflation
+deflator deflected deflector defog deforest defraud defrost deftly defuse defy degraded degrading
+degrease degree dehydrate deity dejected delay delegate delegator delete deletion delicacy delicate
+delicious delighted delirious delirium deliverer delivery delouse delta deluge delusion deluxe
+demanding demeaning demeanor demise democracy democrat demote demotion demystify denatured deniable
+denial denim denote dense density dental dentist denture deny deodorant deodorize departed
+departure depict deplete depletion deplored deploy deport depose depraved depravity deprecate
+depress deprive depth deputize deputy derail deranged derby derived desecrate deserve deserving
+designate designed designer designing deskbound desktop deskwork desolate despair despise despite
+destiny destitute destruct detached detail detection detective detector detention detergent detest
+detonate detonator detoxify detract deuce devalue deviancy deviant deviate deviation deviator
+device devious devotedly devotee devotion devourer devouring devoutly dexterity dexterous diabetes
+diabetic diabolic diagnoses diagnosis diagram dial diameter diaper diaphragm diary dice dicing
+dictate dictation dictator difficult diffused diffuser diffusion diffusive dig dilation diligence
+diligent dill dilute dime diminish dimly dimmed dimmer dimness dimple diner dingbat dinghy
+dinginess dingo dingy dining dinner diocese dioxide diploma dipped dipper dipping directed
+direction directive directly directory direness dirtiness disabled disagree disallow disarm
+disarray disaster disband disbelief disburse discard discern discharge disclose discolor discount
+discourse discover discuss disdain disengage disfigure disgrace dish disinfect disjoin disk dislike
+disliking dislocate dislodge disloyal dismantle dismay dismiss dismount disobey disorder disown
+disparate disparity dispatch dispense dispersal dispersed disperser displace display displease
+disposal dispose disprove dispute disregard disrupt dissuade distance distant distaste distill
+distinct distort distract distress district distrust ditch ditto ditzy dividable divided dividend
+dividers dividing divinely diving divinity divisible divisibly division divisive divorcee dizziness
+dizzy doable docile dock doctrine document dodge dodgy doily doing dole dollar dollhouse dollop
+dolly dolphin domain domelike domestic dominion dominoes donated donation donator donor donut
+doodle doorbell doorframe doorknob doorman doormat doornail doorpost doorstep doorstop doorway
+doozy dork dormitory dorsal dosage dose dotted doubling douche dove down dowry doze drab dragging
+dragonfly dragonish dragster drainable drainage drained drainer drainpipe dramatic dramatize drank
+drapery drastic draw dreaded dreadful dreadlock dreamboat dreamily dreamland dreamless dreamlike
+dreamt dreamy drearily dreary drench dress drew dribble dried drier drift driller drilling
+drinkable drinking dripping drippy drivable driven driver driveway driving drizzle drizzly drone
+drool droop drop-down dropbox dropkick droplet dropout dropper drove drown drowsily drudge drum dry
+dubbed dubiously duchess duckbill ducking duckling ducktail ducky duct dude duffel dugout duh duke
+duller dullness duly dumping dumpling dumpster duo dupe duplex duplicate duplicity durable durably
+duration duress during dusk dust dutiful duty duvet dwarf dweeb dwelled dweller dwelling dwindle
+dwindling dynamic dynamite dynasty dyslexia dyslexic each eagle earache eardrum earflap earful
+earlobe early earmark earmuff earphone earpiece earplugs earring earshot earthen earthlike
+earthling earthly earthworm earthy earwig easeful easel easiest easily easiness easing eastbound
+eastcoast easter eastward eatable eaten eatery eating eats ebay ebony ebook ecard eccentric echo
+eclair eclipse ecologist ecology economic economist economy ecosphere ecosystem edge edginess
+edging edgy edition editor educated education educator eel effective effects efficient effort
+eggbeater egging eggnog eggplant eggshell egomaniac egotism egotistic either eject elaborate
+elastic elated elbow eldercare elderly eldest electable election elective elephant elevate
+elevating elevation elevator eleven elf eligible eligibly eliminate elite elitism elixir elk
+ellipse elliptic elm elongated elope eloquence eloquent elsewhere elude elusive elves email embargo
+embark embassy embattled embellish ember embezzle emblaze emblem embody embolism emboss embroider
+emcee emerald emergency emission emit emote emoticon emotion empathic empathy emperor emphases
+emphasis emphasize emphatic empirical employed employee employer emporium empower emptier emptiness
+empty emu enable enactment enamel enchanted enchilada encircle enclose enclosure encode encore
+encounter encourage encroach encrust encrypt endanger endeared endearing ended ending endless
+endnote endocrine endorphin endorse endowment endpoint endurable endurance enduring energetic
+energize energy enforced enforcer engaged engaging engine engorge engraved engraver engraving
+engross engulf enhance enigmatic enjoyable enjoyably enjoyer enjoying enjoyment enlarged enlarging
+enlighten enlisted enquirer enrage enrich enroll enslave ensnare ensure entail entangled entering
+entertain enticing entire entitle entity entomb entourage entrap entree entrench entrust entryway
+entwine enunciate envelope enviable enviably envious envision envoy envy enzyme epic epidemic
+epidermal epidermis epidural epilepsy epileptic epilogue epiphany episode equal equate equation
+equator equinox equipment equity equivocal eradicate erasable erased eraser erasure ergonomic
+errand errant erratic error erupt escalate escalator escapable escapade escapist escargot eskimo
+esophagus espionage espresso esquire essay essence essential establish estate esteemed estimate
+estimator estranged estrogen etching eternal eternity ethanol ether ethically ethics euphemism
+evacuate evacuee evade evaluate evaluator evaporate evasion evasive even everglade evergreen
+everybody everyday everyone evict evidence evident evil evoke evolution evolve exact exalted
+example excavate excavator exceeding exception excess exchange excitable exciting exclaim exclude
+excluding exclusion exclusive excretion excretory excursion excusable excusably excuse exemplary
+exemplify exemption exerciser exert exes exfoliate exhale exhaust exhume exile existing exit exodus
+exonerate exorcism exorcist expand expanse expansion expansive expectant expedited expediter expel
+expend expenses expensive expert expire expiring explain expletive explicit explode exploit explore
+exploring exponent exporter exposable expose exposure express expulsion exquisite extended
+extending extent extenuate exterior external extinct extortion extradite extras extrovert extrude
+extruding exuberant fable fabric fabulous facebook facecloth facedown faceless facelift faceplate
+faceted facial facility facing facsimile faction factoid factor factsheet factual faculty fade
+fading failing falcon fall false falsify fame familiar family famine famished fanatic fancied
+fanciness fancy fanfare fang fanning fantasize fantastic fantasy fascism fastball faster fasting
+fastness faucet favorable favorably favored favoring favorite fax feast federal fedora feeble feed
+feel feisty feline felt-tip feminine feminism feminist feminize femur fence fencing fender ferment
+fernlike ferocious ferocity ferret ferris ferry fervor fester festival festive festivity fetal
+fetch fever fiber fiction fiddle fiddling fidelity fidgeting fidgety fifteen fifth fiftieth fifty
+figment figure figurine filing filled filler filling film filter filth filtrate finale finalist
+finalize finally finance financial finch fineness finer finicky finished finisher finishing finite
+finless finlike fiscally fit five flaccid flagman flagpole flagship flagstick flagstone flail
+flakily flaky flame flammable flanked flanking flannels flap flaring flashback flashbulb flashcard
+flashily flashing flashy flask flatbed flatfoot flatly flatness flatten flattered flatterer
+flattery flattop flatware flatworm flavored flavorful flavoring flaxseed fled fleshed fleshy flick
+flier flight flinch fling flint flip flirt float flock flogging flop floral florist floss flounder
+flyable flyaway flyer flying flyover flypaper foam foe fog foil folic folk follicle follow fondling
+fondly fondness fondue font food fool footage football footbath footboard footer footgear foothill
+foothold footing footless footman footnote footpad footpath footprint footrest footsie footsore
+footwear footwork fossil foster founder founding fountain fox foyer fraction fracture fragile
+fragility fragment fragrance fragrant frail frame framing frantic fraternal frayed fraying frays
+freckled freckles freebase freebee freebie freedom freefall freehand freeing freeload freely
+freemason freeness freestyle freeware freeway freewill freezable freezing freight french frenzied
+frenzy frequency frequent fresh fretful fretted friction friday fridge fried friend frighten
+frightful frigidity frigidly frill fringe frisbee frisk fritter frivolous frolic from front
+frostbite frosted frostily frosting frostlike frosty froth frown frozen fructose frugality frugally
+fruit frustrate frying gab gaffe gag gainfully gaining gains gala gallantly galleria gallery galley
+gallon gallows gallstone galore galvanize gambling game gaming gamma gander gangly gangrene gangway
+gap garage garbage garden gargle garland garlic garment garnet garnish garter gas gatherer
+gathering gating gauging gauntlet gauze gave gawk gazing gear gecko geek geiger gem gender generic
+generous genetics genre gentile gentleman gently gents geography geologic geologist geology
+geometric geometry geranium gerbil geriatric germicide germinate germless germproof gestate
+gestation gesture getaway getting getup giant gibberish giblet giddily giddiness giddy gift
+gigabyte gigahertz gigantic giggle giggling giggly gigolo gilled gills gimmick girdle giveaway
+given giver giving gizmo gizzard glacial glacier glade gladiator gladly glamorous glamour glance
+glancing glandular glare glaring glass glaucoma glazing gleaming gleeful glider gliding glimmer
+glimpse glisten glitch glitter glitzy gloater gloating gloomily gloomy glorified glorifier glorify
+glorious glory gloss glove glowing glowworm glucose glue gluten glutinous glutton gnarly gnat goal
+goatskin goes goggles going goldfish goldmine goldsmith golf goliath gonad gondola gone gong good
+gooey goofball goofiness goofy google goon gopher gore gorged gorgeous gory gosling gossip gothic
+gotten gout gown grab graceful graceless gracious gradation graded grader gradient grading
+gradually graduate graffiti grafted grafting grain granddad grandkid grandly grandma grandpa
+grandson granite granny granola grant granular grape graph grapple grappling grasp grass gratified
+gratify grating gratitude gratuity gravel graveness graves graveyard gravitate gravity gravy gray
+grazing greasily greedily greedless greedy green greeter greeting grew greyhound grid grief
+grievance grieving grievous grill grimace grimacing grime griminess grimy grinch grinning grip
+gristle grit groggily groggy groin groom groove grooving groovy grope ground grouped grout grove
+grower growing growl grub grudge grudging grueling gruffly grumble grumbling grumbly grumpily
+grunge grunt guacamole guidable guidance guide guiding guileless guise gulf gullible gully gulp
+gumball gumdrop gumminess gumming gummy gurgle gurgling guru gush gusto gusty gutless guts gutter
+guy guzzler gyration habitable habitant habitat habitual hacked hacker hacking hacksaw had haggler
+haiku half halogen halt halved halves hamburger hamlet hammock hamper hamster hamstring handbag
+handball handbook handbrake handcart handclap handclasp handcraft handcuff handed handful handgrip
+handgun handheld handiness handiwork handlebar handled handler handling handmade handoff handpick
+handprint handrail handsaw handset handsfree handshake handstand handwash handwork handwoven
+handwrite handyman hangnail hangout hangover hangup hankering hankie hanky haphazard happening
+happier happiest happily happiness happy harbor hardcopy hardcore hardcover harddisk hardened
+hardener hardening hardhat hardhead hardiness hardly hardness hardship hardware hardwired hardwood
+hardy harmful harmless harmonica harmonics harmonize harmony harness harpist harsh harvest hash
+hassle haste hastily hastiness hasty hatbox hatchback hatchery hatchet hatching hatchling hate
+hatless hatred haunt haven hazard hazelnut hazily haziness hazing hazy headache headband headboard
+headcount headdress headed header headfirst headgear heading headlamp headless headlock headphone
+headpiece headrest headroom headscarf headset headsman headstand headstone headway headwear heap
+heat heave heavily heaviness heaving hedge hedging heftiness hefty helium helmet helper helpful
+helping helpless helpline hemlock hemstitch hence henchman henna herald herbal herbicide herbs
+heritage hermit heroics heroism herring herself hertz hesitancy hesitant hesitate hexagon hexagram
+hubcap huddle huddling huff hug hula hulk hull human humble humbling humbly humid humiliate
+humility humming hummus humongous humorist humorless humorous humpback humped humvee hunchback
+hundredth hunger hungrily hungry hunk hunter hunting huntress huntsman hurdle hurled hurler hurling
+hurray hurricane hurried hurry hurt husband hush husked huskiness hut hybrid hydrant hydrated
+hydration hydrogen hydroxide hyperlink hypertext hyphen hypnoses hypnosis hypnotic hypnotism
+hypnotist hypnotize hypocrisy hypocrite ibuprofen ice iciness icing icky icon icy idealism idealist
+idealize ideally idealness identical identify identity ideology idiocy idiom idly igloo ignition
+ignore iguana illicitly illusion illusive image imaginary imagines imaging imbecile imitate
+imitation immature immerse immersion imminent immobile immodest immorally immortal immovable
+immovably immunity immunize impaired impale impart impatient impeach impeding impending imperfect
+imperial impish implant implement implicate implicit implode implosion implosive imply impolite
+important importer impose imposing impotence impotency impotent impound imprecise imprint imprison
+impromptu improper improve improving improvise imprudent impulse impulsive impure impurity iodine
+iodize ion ipad iphone ipod irate irk iron irregular irrigate irritable irritably irritant irritate
+islamic islamist isolated isolating isolation isotope issue issuing italicize italics item
+itinerary itunes ivory ivy jab jackal jacket jackknife jackpot jailbird jailbreak jailer jailhouse
+jalapeno jam janitor january jargon jarring jasmine jaundice jaunt java jawed jawless jawline jaws
+jaybird jaywalker jazz jeep jeeringly jellied jelly jersey jester jet jiffy jigsaw jimmy jingle
+jingling jinx jitters jittery job jockey jockstrap jogger jogging john joining jokester jokingly
+jolliness jolly jolt jot jovial joyfully joylessly joyous joyride joystick jubilance jubilant judge
+judgingly judicial judiciary judo juggle juggling jugular juice juiciness juicy jujitsu jukebox
+july jumble jumbo jump junction juncture june junior juniper junkie junkman junkyard jurist juror
+jury justice justifier justify justly justness juvenile kabob kangaroo karaoke karate karma kebab
+keenly keenness keep keg kelp kennel kept kerchief kerosene kettle kick kiln kilobyte kilogram
+kilometer kilowatt kilt kimono kindle kindling kindly kindness kindred kinetic kinfolk king kinship
+kinsman kinswoman kissable kisser kissing kitchen kite kitten kitty kiwi kleenex knapsack knee
+knelt knickers knoll koala kooky kosher krypton kudos kung labored laborer laboring laborious
+labrador ladder ladies ladle ladybug ladylike lagged lagging lagoon lair lake lance landed landfall
+landfill landing landlady landless landline landlord landmark landmass landmine landowner landscape
+landside landslide language lankiness lanky lantern lapdog lapel lapped lapping laptop lard large
+lark lash lasso last latch late lather latitude latrine latter latticed launch launder laundry
+laurel lavender lavish laxative lazily laziness lazy lecturer left legacy legal legend legged
+leggings legible legibly legislate lego legroom legume legwarmer legwork lemon lend length lens
+lent leotard lesser letdown lethargic lethargy letter lettuce level leverage levers levitate
+levitator liability liable liberty librarian library licking licorice lid life lifter lifting
+liftoff ligament likely likeness likewise liking lilac lilly lily limb limeade limelight limes
+limit limping limpness line lingo linguini linguist lining linked linoleum linseed lint lion lip
+liquefy liqueur liquid lisp list litigate litigator litmus litter little livable lived lively liver
+livestock lividly living lizard lubricant lubricate lucid luckily luckiness luckless lucrative
+ludicrous lugged lukewarm lullaby lumber luminance luminous lumpiness lumping lumpish lunacy lunar
+lunchbox luncheon lunchroom lunchtime lung lurch lure luridness lurk lushly lushness luster
+lustfully lustily lustiness lustrous lusty luxurious luxury lying lyrically lyricism lyricist
+lyrics macarena macaroni macaw mace machine machinist magazine magenta maggot magical magician
+magma magnesium magnetic magnetism magnetize magnifier magnify magnitude magnolia mahogany maimed
+majestic majesty majorette majority makeover maker makeshift making malformed malt mama mammal
+mammary mammogram manager managing manatee mandarin mandate mandatory mandolin manger mangle mango
+mangy manhandle manhole manhood manhunt manicotti manicure manifesto manila mankind manlike
+manliness manly manmade manned mannish manor manpower mantis mantra manual many map marathon
+marauding marbled marbles marbling march mardi margarine margarita margin marigold marina marine
+marital maritime marlin marmalade maroon married marrow marry marshland marshy marsupial marvelous
+marxism mascot masculine mashed mashing massager masses massive mastiff matador matchbook matchbox
+matcher matching matchless material maternal maternity math mating matriarch matrimony matrix
+matron matted matter maturely maturing maturity mauve maverick maximize maximum maybe mayday
+mayflower moaner moaning mobile mobility mobilize mobster mocha mocker mockup modified modify
+modular modulator module moisten moistness moisture molar molasses mold molecular molecule molehill
+mollusk mom monastery monday monetary monetize moneybags moneyless moneywise mongoose mongrel
+monitor monkhood monogamy monogram monologue monopoly monorail monotone monotype monoxide monsieur
+monsoon monstrous monthly monument moocher moodiness moody mooing moonbeam mooned moonlight
+moonlike moonlit moonrise moonscape moonshine moonstone moonwalk mop morale morality morally
+morbidity morbidly morphine morphing morse mortality mortally mortician mortified mortify mortuary
+mosaic mossy most mothball mothproof motion motivate motivator motive motocross motor motto
+mountable mountain mounted mounting mourner mournful mouse mousiness moustache mousy mouth movable
+move movie moving mower mowing much muck mud mug mulberry mulch mule mulled mullets multiple
+multiply multitask multitude mumble mumbling mumbo mummified mummify mummy mumps munchkin mundane
+municipal muppet mural murkiness murky murmuring muscular museum mushily mushiness mushroom mushy
+music musket muskiness musky mustang mustard muster mustiness musty mutable mutate mutation mute
+mutilated mutilator mutiny mutt mutual muzzle myself myspace mystified mystify myth nacho nag nail
+name naming nanny nanometer nape napkin napped napping nappy narrow nastily nastiness national
+native nativity natural nature naturist nautical navigate navigator navy nearby nearest nearly
+nearness neatly neatness nebula nebulizer nectar negate negation negative neglector negligee
+negligent negotiate nemeses nemesis neon nephew nerd nervous nervy nest net neurology neuron
+neurosis neurotic neuter neutron never next nibble nickname nicotine niece nifty nimble nimbly
+nineteen ninetieth ninja nintendo ninth nuclear nuclei nucleus nugget nullify number numbing numbly
+numbness numeral numerate numerator numeric numerous nuptials nursery nursing nurture nutcase
+nutlike nutmeg nutrient nutshell nuttiness nutty nuzzle nylon oaf oak oasis oat obedience obedient
+obituary object obligate obliged oblivion oblivious oblong obnoxious oboe obscure obscurity
+observant observer observing obsessed obsession obsessive obsolete obstacle obstinate obstruct
+obtain obtrusive obtuse obvious occultist occupancy occupant occupier occupy ocean ocelot octagon
+octane october octopus ogle oil oink ointment okay old olive olympics omega omen ominous omission
+omit omnivore onboard oncoming ongoing onion online onlooker only onscreen onset onshore onslaught
+onstage onto onward onyx oops ooze oozy opacity opal open operable operate operating operation
+operative operator opium opossum opponent oppose opposing opposite oppressed oppressor opt
+opulently osmosis other otter ouch ought ounce outage outback outbid outboard outbound outbreak
+outburst outcast outclass outcome outdated outdoors outer outfield outfit outflank outgoing outgrow
+outhouse outing outlast outlet outline outlook outlying outmatch outmost outnumber outplayed
+outpost outpour output outrage outrank outreach outright outscore outsell outshine outshoot
+outsider outskirts outsmart outsource outspoken outtakes outthink outward outweigh outwit oval
+ovary oven overact overall overarch overbid overbill overbite overblown overboard overbook
+overbuilt overcast overcoat overcome overcook overcrowd overdraft overdrawn overdress overdrive
+overdue overeager overeater overexert overfed overfeed overfill overflow overfull overgrown
+overhand overhang overhaul overhead overhear overheat overhung overjoyed overkill overlabor
+overlaid overlap overlay overload overlook overlord overlying overnight overpass overpay overplant
+overplay overpower overprice overrate overreach overreact override overripe overrule overrun
+overshoot overshot oversight oversized oversleep oversold overspend overstate overstay overstep
+overstock overstuff oversweet overtake overthrow overtime overtly overtone overture overturn
+overuse overvalue overview overwrite owl oxford oxidant oxidation oxidize oxidizing oxygen oxymoron
+oyster ozone paced pacemaker pacific pacifier pacifism pacifist pacify padded padding paddle
+paddling padlock pagan pager paging pajamas palace palatable palm palpable palpitate paltry
+pampered pamperer pampers pamphlet panama pancake pancreas panda pandemic pang panhandle panic
+panning panorama panoramic panther pantomime pantry pants pantyhose paparazzi papaya paper paprika
+papyrus parabola parachute parade paradox paragraph parakeet paralegal paralyses paralysis paralyze
+paramedic parameter paramount parasail parasite parasitic parcel parched parchment pardon parish
+parka parking parkway parlor parmesan parole parrot parsley parsnip partake parted parting
+partition partly partner partridge party passable passably passage passcode passenger passerby
+passing passion passive passivism passover passport password pasta pasted pastel pastime pastor
+pastrami pasture pasty patchwork patchy paternal paternity path patience patient patio patriarch
+patriot patrol patronage patronize pauper pavement paver pavestone pavilion paving pawing payable
+payback paycheck payday payee payer paying payment payphone payroll pebble pebbly pecan pectin
+peculiar peddling pediatric pedicure pedigree pedometer pegboard pelican pellet pelt pelvis
+penalize penalty pencil pendant pending penholder penknife pennant penniless penny penpal pension
+pentagon pentagram pep perceive percent perch percolate perennial perfected perfectly perfume
+periscope perish perjurer perjury perkiness perky perm peroxide perpetual perplexed persecute
+persevere persuaded persuader pesky peso pessimism pessimist pester pesticide petal petite petition
+petri petroleum petted petticoat pettiness petty petunia phantom phobia phoenix phonebook phoney
+phonics phoniness phony phosphate photo phrase phrasing placard placate placidly plank planner
+plant plasma plaster plastic plated platform plating platinum platonic platter platypus plausible
+plausibly playable playback player playful playgroup playhouse playing playlist playmaker playmate
+playoff playpen playroom playset plaything playtime plaza pleading pleat pledge plentiful plenty
+plethora plexiglas pliable plod plop plot plow ploy pluck plug plunder plunging plural plus
+plutonium plywood poach pod poem poet pogo pointed pointer pointing pointless pointy poise poison
+poker poking polar police policy polio polish politely polka polo polyester polygon polygraph
+polymer poncho pond pony popcorn pope poplar popper poppy popsicle populace popular populate
+porcupine pork porous porridge portable portal portfolio porthole portion portly portside poser
+posh posing possible possibly possum postage postal postbox postcard posted poster posting
+postnasal posture postwar pouch pounce pouncing pound pouring pout powdered powdering powdery power
+powwow pox praising prance prancing pranker prankish prankster prayer praying preacher preaching
+preachy preamble precinct precise precision precook precut predator predefine predict preface
+prefix preflight preformed pregame pregnancy pregnant preheated prelaunch prelaw prelude premiere
+premises premium prenatal preoccupy preorder prepaid prepay preplan preppy preschool prescribe
+preseason preset preshow president presoak press presume presuming preteen pretended pretender
+pretense pretext pretty pretzel prevail prevalent prevent preview previous prewar prewashed
+prideful pried primal primarily primary primate primer primp princess print prior prism prison
+prissy pristine privacy private privatize prize proactive probable probably probation probe probing
+probiotic problem procedure process proclaim procreate procurer prodigal prodigy produce product
+profane profanity professed professor profile profound profusely progeny prognosis program progress
+projector prologue prolonged promenade prominent promoter promotion prompter promptly prone prong
+pronounce pronto proofing proofread proofs propeller properly property proponent proposal propose
+props prorate protector protegee proton prototype protozoan protract protrude proud provable proved
+proven provided provider providing province proving provoke provoking provolone prowess prowler
+prowling proximity proxy prozac prude prudishly prune pruning pry psychic public publisher pucker
+pueblo pug pull pulmonary pulp pulsate pulse pulverize puma pumice pummel punch punctual punctuate
+punctured pungent punisher punk pupil puppet puppy purchase pureblood purebred purely pureness
+purgatory purge purging purifier purify purist puritan purity purple purplish purposely purr purse
+pursuable pursuant pursuit purveyor pushcart pushchair pusher pushiness pushing pushover pushpin
+pushup pushy putdown putt puzzle puzzling pyramid pyromania python quack quadrant quail quaintly
+quake quaking qualified qualifier qualify quality qualm quantum quarrel quarry quartered quarterly
+quarters quartet quench query quicken quickly quickness quicksand quickstep quiet quill quilt
+quintet quintuple quirk quit quiver quizzical quotable quotation quote rabid race racing racism
+rack racoon radar radial radiance radiantly radiated radiation radiator radio radish raffle raft
+rage ragged raging ragweed raider railcar railing railroad railway raisin rake raking rally ramble
+rambling ramp ramrod ranch rancidity random ranged ranger ranging ranked ranking ransack ranting
+rants rare rarity rascal rash rasping ravage raven ravine raving ravioli ravishing reabsorb reach
+reacquire reaction reactive reactor reaffirm ream reanalyze reappear reapply reappoint reapprove
+rearrange rearview reason reassign reassure reattach reawake rebalance rebate rebel rebirth reboot
+reborn rebound rebuff rebuild rebuilt reburial rebuttal recall recant recapture recast recede
+recent recess recharger recipient recital recite reckless reclaim recliner reclining recluse
+reclusive recognize recoil recollect recolor reconcile reconfirm reconvene recopy record recount
+recoup recovery recreate rectal rectangle rectified rectify recycled recycler recycling reemerge
+reenact reenter reentry reexamine referable referee reference refill refinance refined refinery
+refining refinish reflected reflector reflex reflux refocus refold reforest reformat reformed
+reformer reformist refract refrain refreeze refresh refried refueling refund refurbish refurnish
+refusal refuse refusing refutable refute regain regalia regally reggae regime region register
+registrar registry regress regretful regroup regular regulate regulator rehab reheat rehire
+rehydrate reimburse reissue reiterate rejoice rejoicing rejoin rekindle relapse relapsing relatable
+related relation relative relax relay relearn release relenting reliable reliably reliance reliant
+relic relieve relieving relight relish relive reload relocate relock reluctant rely remake remark
+remarry rematch remedial remedy remember reminder remindful remission remix remnant remodeler
+remold remorse remote removable removal removed remover removing rename renderer rendering
+rendition renegade renewable renewably renewal renewed renounce renovate renovator rentable rental
+rented renter reoccupy reoccur reopen reorder repackage repacking repaint repair repave repaying
+repayment repeal repeated repeater repent rephrase replace replay replica reply reporter repose
+repossess repost repressed reprimand reprint reprise reproach reprocess reproduce reprogram reps
+reptile reptilian repugnant repulsion repulsive repurpose reputable reputably request require
+requisite reroute rerun resale resample rescuer reseal research reselect reseller resemble resend
+resent reset reshape reshoot reshuffle residence residency resident residual residue resigned
+resilient resistant resisting resize resolute resolved resonant resonate resort resource respect
+resubmit result resume resupply resurface resurrect retail retainer retaining retake retaliate
+retention rethink retinal retired retiree retiring retold retool retorted retouch retrace retract
+retrain retread retreat retrial retrieval retriever retry return retying retype reunion reunite
+reusable reuse reveal reveler revenge revenue reverb revered reverence reverend reversal reverse
+reversing reversion revert revisable revise revision revisit revivable revival reviver reviving
+revocable revoke revolt revolver revolving reward rewash rewind rewire reword rework rewrap rewrite
+rhyme ribbon ribcage rice riches richly richness rickety ricotta riddance ridden ride riding
+rifling rift rigging rigid rigor rimless rimmed rind rink rinse rinsing riot ripcord ripeness
+ripening ripping ripple rippling riptide rise rising risk risotto ritalin ritzy rival riverbank
+riverbed riverboat riverside riveter riveting roamer roaming roast robbing robe robin robotics
+robust rockband rocker rocket rockfish rockiness rocking rocklike rockslide rockstar rocky rogue
+roman romp rope roping roster rosy rotten rotting rotunda roulette rounding roundish roundness
+roundup roundworm routine routing rover roving royal rubbed rubber rubbing rubble rubdown ruby
+ruckus rudder rug ruined rule rumble rumbling rummage rumor runaround rundown runner running runny
+runt runway rupture rural ruse rush rust rut sabbath sabotage sacrament sacred sacrifice sadden
+saddlebag saddled saddling sadly sadness safari safeguard safehouse safely safeness saffron saga
+sage sagging saggy said saint sake salad salami salaried salary saline salon saloon salsa salt
+salutary salute salvage salvaging salvation same sample sampling sanction sanctity sanctuary sandal
+sandbag sandbank sandbar sandblast sandbox sanded sandfish sanding sandlot sandpaper sandpit
+sandstone sandstorm sandworm sandy sanitary sanitizer sank santa sapling sappiness sappy sarcasm
+sarcastic sardine sash sasquatch sassy satchel satiable satin satirical satisfied satisfy saturate
+saturday sauciness saucy sauna savage savanna saved savings savior savor saxophone say scabbed
+scabby scalded scalding scale scaling scallion scallop scalping scam scandal scanner scanning scant
+scapegoat scarce scarcity scarecrow scared scarf scarily scariness scarring scary scavenger scenic
+schedule schematic scheme scheming schilling schnapps scholar science scientist scion scoff
+scolding scone scoop scooter scope scorch scorebook scorecard scored scoreless scorer scoring scorn
+scorpion scotch scoundrel scoured scouring scouting scouts scowling scrabble scraggly scrambled
+scrambler scrap scratch scrawny screen scribble scribe scribing scrimmage script scroll scrooge
+scrounger scrubbed scrubber scruffy scrunch scrutiny scuba scuff sculptor sculpture scurvy scuttle
+secluded secluding seclusion second secrecy secret sectional sector secular securely security sedan
+sedate sedation sedative sediment seduce seducing segment seismic seizing seldom selected selection
+selective selector self seltzer semantic semester semicolon semifinal seminar semisoft semisweet
+senate senator send senior senorita sensation sensitive sensitize sensually sensuous sepia
+september septic septum sequel sequence sequester series sermon serotonin serpent serrated serve
+service serving sesame sessions setback setting settle settling setup sevenfold seventeen seventh
+seventy severity shabby shack shaded shadily shadiness shading shadow shady shaft shakable shakily
+shakiness shaking shaky shale shallot shallow shame shampoo shamrock shank shanty shape shaping
+share sharpener sharper sharpie sharply sharpness shawl sheath shed sheep sheet shelf shell shelter
+shelve shelving sherry shield shifter shifting shiftless shifty shimmer shimmy shindig shine
+shingle shininess shining shiny ship shirt shivering shock shone shoplift shopper shopping shoptalk
+shore shortage shortcake shortcut shorten shorter shorthand shortlist shortly shortness shorts
+shortwave shorty shout shove showbiz showcase showdown shower showgirl showing showman shown
+showoff showpiece showplace showroom showy shrank shrapnel shredder shredding shrewdly shriek
+shrill shrimp shrine shrink shrivel shrouded shrubbery shrubs shrug shrunk shucking shudder shuffle
+shuffling shun shush shut shy siamese siberian sibling siding sierra siesta sift sighing silenced
+silencer silent silica silicon silk silliness silly silo silt silver similarly simile simmering
+simple simplify simply sincere sincerity singer singing single singular sinister sinless sinner
+sinuous sip siren sister sitcom sitter sitting situated situation sixfold sixteen sixth sixties
+sixtieth sixtyfold sizable sizably size sizing sizzle sizzling skater skating skedaddle skeletal
+skeleton skeptic sketch skewed skewer skid skied skier skies skiing skilled skillet skillful
+skimmed skimmer skimming skimpily skincare skinhead skinless skinning skinny skintight skipper
+skipping skirmish skirt skittle skydiver skylight skyline skype skyrocket skyward slab slacked
+slacker slacking slackness slacks slain slam slander slang slapping slapstick slashed slashing
+slate slather slaw sled sleek sleep sleet sleeve slept sliceable sliced slicer slicing slick slider
+slideshow sliding slighted slighting slightly slimness slimy slinging slingshot slinky slip slit
+sliver slobbery slogan sloped sloping sloppily sloppy slot slouching slouchy sludge slug slum slurp
+slush sly small smartly smartness smasher smashing smashup smell smelting smile smilingly smirk
+smite smith smitten smock smog smoked smokeless smokiness smoking smoky smolder smooth smother
+smudge smudgy smuggler smuggling smugly smugness snack snagged snaking snap snare snarl snazzy
+sneak sneer sneeze sneezing snide sniff snippet snipping snitch snooper snooze snore snoring
+snorkel snort snout snowbird snowboard snowbound snowcap snowdrift snowdrop snowfall snowfield
+snowflake snowiness snowless snowman snowplow snowshoe snowstorm snowsuit snowy snub snuff snuggle
+snugly snugness speak spearfish spearhead spearman spearmint species specimen specked speckled
+specks spectacle spectator spectrum speculate speech speed spellbind speller spelling spendable
+spender spending spent spew sphere spherical sphinx spider spied spiffy spill spilt spinach spinal
+spindle spinner spinning spinout spinster spiny spiral spirited spiritism spirits spiritual
+splashed splashing splashy splatter spleen splendid splendor splice splicing splinter splotchy
+splurge spoilage spoiled spoiler spoiling spoils spoken spokesman sponge spongy sponsor spoof
+spookily spooky spool spoon spore sporting sports sporty spotless spotlight spotted spotter
+spotting spotty spousal spouse spout sprain sprang sprawl spray spree sprig spring sprinkled
+sprinkler sprint sprite sprout spruce sprung spry spud spur sputter spyglass squabble squad squall
+squander squash squatted squatter squatting squeak squealer squealing squeamish squeegee squeeze
+squeezing squid squiggle squiggly squint squire squirt squishier squishy stability stabilize stable
+stack stadium staff stage staging stagnant stagnate stainable stained staining stainless stalemate
+staleness stalling stallion stamina stammer stamp stand stank staple stapling starboard starch
+stardom stardust starfish stargazer staring stark starless starlet starlight starlit starring
+starry starship starter starting startle startling startup starved starving stash state static
+statistic statue stature status statute statutory staunch stays steadfast steadier steadily
+steadying steam steed steep steerable steering steersman stegosaur stellar stem stench stencil step
+stereo sterile sterility sterilize sterling sternness sternum stew stick stiffen stiffly stiffness
+stifle stifling stillness stilt stimulant stimulate stimuli stimulus stinger stingily stinging
+stingray stingy stinking stinky stipend stipulate stir stitch stock stoic stoke stole stomp
+stonewall stoneware stonework stoning stony stood stooge stool stoop stoplight stoppable stoppage
+stopped stopper stopping stopwatch storable storage storeroom storewide storm stout stove stowaway
+stowing straddle straggler strained strainer straining strangely stranger strangle strategic
+strategy stratus straw stray streak stream street strength strenuous strep stress stretch strewn
+stricken strict stride strife strike striking strive striving strobe strode stroller strongbox
+strongly strongman struck structure strudel struggle strum strung strut stubbed stubble stubbly
+stubborn stucco stuck student studied studio study stuffed stuffing stuffy stumble stumbling stump
+stung stunned stunner stunning stunt stupor sturdily sturdy styling stylishly stylist stylized
+stylus suave subarctic subatomic subdivide subdued subduing subfloor subgroup subheader subject
+sublease sublet sublevel sublime submarine submerge submersed submitter subpanel subpar subplot
+subprime subscribe subscript subsector subside subsiding subsidize subsidy subsoil subsonic
+substance subsystem subtext subtitle subtly subtotal subtract subtype suburb subway subwoofer
+subzero succulent such suction sudden sudoku suds sufferer suffering suffice suffix suffocate
+suffrage sugar suggest suing suitable suitably suitcase suitor sulfate sulfide sulfite sulfur sulk
+sullen sulphate sulphuric sultry superbowl superglue superhero superior superjet superman supermom
+supernova supervise supper supplier supply support supremacy supreme surcharge surely sureness
+surface surfacing surfboard surfer surgery surgical surging surname surpass surplus surprise
+surreal surrender surrogate surround survey survival survive surviving survivor sushi suspect
+suspend suspense sustained sustainer swab swaddling swagger swampland swan swapping swarm sway
+swear sweat sweep swell swept swerve swifter swiftly swiftness swimmable swimmer swimming swimsuit
+swimwear swinger swinging swipe swirl switch swivel swizzle swooned swoop swoosh swore sworn swung
+sycamore sympathy symphonic symphony symptom synapse syndrome synergy synopses synopsis synthesis
+synthetic syrup system t-shirt tabasco tabby tableful tables tablet tableware tabloid tackiness
+tacking tackle tackling tacky taco tactful tactical tactics tactile tactless tadpole taekwondo tag
+tainted take taking talcum talisman tall talon tamale tameness tamer tamper tank tanned tannery
+tanning tantrum tapeless tapered tapering tapestry tapioca tapping taps tarantula target tarmac
+tarnish tarot tartar tartly tartness task tassel taste tastiness tasting tasty tattered tattle
+tattling tattoo taunt tavern thank that thaw theater theatrics thee theft theme theology theorize
+thermal thermos thesaurus these thesis thespian thicken thicket thickness thieving thievish thigh
+thimble thing think thinly thinner thinness thinning thirstily thirsting thirsty thirteen thirty
+thong thorn those thousand thrash thread threaten threefold thrift thrill thrive thriving throat
+throbbing throng throttle throwaway throwback thrower throwing thud thumb thumping thursday thus
+thwarting thyself tiara tibia tidal tidbit tidiness tidings tidy tiger tighten tightly tightness
+tightrope tightwad tigress tile tiling till tilt timid timing timothy tinderbox tinfoil tingle
+tingling tingly tinker tinkling tinsel tinsmith tint tinwork tiny tipoff tipped tipper tipping
+tiptoeing tiptop tiring tissue trace tracing track traction tractor trade trading tradition traffic
+tragedy trailing trailside train traitor trance tranquil transfer transform translate transpire
+transport transpose trapdoor trapeze trapezoid trapped trapper trapping traps trash travel traverse
+travesty tray treachery treading treadmill treason treat treble tree trekker tremble trembling
+tremor trench trend trespass triage trial triangle tribesman tribunal tribune tributary tribute
+triceps trickery trickily tricking trickle trickster tricky tricolor tricycle trident tried trifle
+trifocals trillion trilogy trimester trimmer trimming trimness trinity trio tripod tripping triumph
+trivial trodden trolling trombone trophy tropical tropics trouble troubling trough trousers trout
+trowel truce truck truffle trump trunks trustable trustee trustful trusting trustless truth try
+tubby tubeless tubular tucking tuesday tug tuition tulip tumble tumbling tummy turban turbine
+turbofan turbojet turbulent turf turkey turmoil turret turtle tusk tutor tutu tux tweak tweed tweet
+tweezers twelve twentieth twenty twerp twice twiddle twiddling twig twilight twine twins twirl
+twistable twisted twister twisting twisty twitch twitter tycoon tying tyke udder ultimate ultimatum
+ultra umbilical umbrella umpire unabashed unable unadorned unadvised unafraid unaired unaligned
+unaltered unarmored unashamed unaudited unawake unaware unbaked unbalance unbeaten unbend unbent
+unbiased unbitten unblended unblessed unblock unbolted unbounded unboxed unbraided unbridle
+unbroken unbuckled unbundle unburned unbutton uncanny uncapped uncaring uncertain unchain unchanged
+uncharted uncheck uncivil unclad unclaimed unclamped unclasp uncle unclip uncloak unclog unclothed
+uncoated uncoiled uncolored uncombed uncommon uncooked uncork uncorrupt uncounted uncouple uncouth
+uncover uncross uncrown uncrushed uncured uncurious uncurled uncut undamaged undated undaunted
+undead undecided undefined underage underarm undercoat undercook undercut underdog underdone
+underfed underfeed underfoot undergo undergrad underhand underline underling undermine undermost
+underpaid underpass underpay underrate undertake undertone undertook undertow underuse underwear
+underwent underwire undesired undiluted undivided undocked undoing undone undrafted undress
+undrilled undusted undying unearned unearth unease uneasily uneasy uneatable uneaten unedited
+unelected unending unengaged unenvied unequal unethical uneven unexpired unexposed unfailing unfair
+unfasten unfazed unfeeling unfiled unfilled unfitted unfitting unfixable unfixed unflawed unfocused
+unfold unfounded unframed unfreeze unfrosted unfrozen unfunded unglazed ungloved unglue ungodly
+ungraded ungreased unguarded unguided unhappily unhappy unharmed unhealthy unheard unhearing
+unheated unhelpful unhidden unhinge unhitched unholy unhook unicorn unicycle unified unifier
+uniformed uniformly unify unimpeded uninjured uninstall uninsured uninvited union uniquely
+unisexual unison unissued unit universal universe unjustly unkempt unkind unknotted unknowing
+unknown unlaced unlatch unlawful unleaded unlearned unleash unless unleveled unlighted unlikable
+unlimited unlined unlinked unlisted unlit unlivable unloaded unloader unlocked unlocking unlovable
+unloved unlovely unloving unluckily unlucky unmade unmanaged unmanned unmapped unmarked unmasked
+unmasking unmatched unmindful unmixable unmixed unmolded unmoral unmovable unmoved unmoving
+unnamable unnamed unnatural unneeded unnerve unnerving unnoticed unopened unopposed unpack unpadded
+unpaid unpainted unpaired unpaved unpeeled unpicked unpiloted unpinned unplanned unplanted
+unpleased unpledged unplowed unplug unpopular unproven unquote unranked unrated unraveled unreached
+unread unreal unreeling unrefined unrelated unrented unrest unretired unrevised unrigged unripe
+unrivaled unroasted unrobed unroll unruffled unruly unrushed unsaddle unsafe unsaid unsalted
+unsaved unsavory unscathed unscented unscrew unsealed unseated unsecured unseeing unseemly unseen
+unselect unselfish unsent unsettled unshackle unshaken unshaved unshaven unsheathe unshipped
+unsightly unsigned unskilled unsliced unsmooth unsnap unsocial unsoiled unsold unsolved unsorted
+unspoiled unspoken unstable unstaffed unstamped unsteady unsterile unstirred unstitch unstopped
+unstuck unstuffed unstylish unsubtle unsubtly unsuited unsure unsworn untagged untainted untaken
+untamed untangled untapped untaxed unthawed unthread untidy untie until untimed untimely untitled
+untoasted untold untouched untracked untrained untreated untried untrimmed untrue untruth unturned
+untwist untying unusable unused unusual unvalued unvaried unvarying unveiled unveiling unvented
+unviable unvisited unvocal unwanted unwarlike unwary unwashed unwatched unweave unwed unwelcome
+unwell unwieldy unwilling unwind unwired unwitting unwomanly unworldly unworn unworried unworthy
+unwound unwoven unwrapped unwritten unzip upbeat upchuck upcoming upcountry update upfront upgrade
+upheaval upheld uphill uphold uplifted uplifting upload upon upper upright uprising upriver uproar
+uproot upscale upside upstage upstairs upstart upstate upstream upstroke upswing uptake uptight
+uptown upturned upward upwind uranium urban urchin urethane urgency urgent urging urologist urology
+usable usage useable used uselessly user usher usual utensil utility utilize utmost utopia utter
+vacancy vacant vacate vacation vagabond vagrancy vagrantly vaguely vagueness valiant valid valium
+valley valuables value vanilla vanish vanity vanquish vantage vaporizer variable variably varied
+variety various varmint varnish varsity varying vascular vaseline vastly vastness veal vegan veggie
+vehicular velcro velocity velvet vendetta vending vendor veneering vengeful venomous ventricle
+venture venue venus verbalize verbally verbose verdict verify verse version versus vertebrae
+vertical vertigo very vessel vest veteran veto vexingly viability viable vibes vice vicinity
+victory video viewable viewer viewing viewless viewpoint vigorous village villain vindicate
+vineyard vintage violate violation violator violet violin viper viral virtual virtuous virus visa
+viscosity viscous viselike visible visibly vision visiting visitor visor vista vitality vitalize
+vitally vitamins vivacious vividly vividness vixen vocalist vocalize vocally vocation voice voicing
+void volatile volley voltage volumes voter voting voucher vowed vowel voyage wackiness wad wafer
+waffle waged wager wages waggle wagon wake waking walk walmart walnut walrus waltz wand wannabe
+wanted wanting wasabi washable washbasin washboard washbowl washcloth washday washed washer
+washhouse washing washout washroom washstand washtub wasp wasting watch water waviness waving wavy
+whacking whacky wham wharf wheat whenever whiff whimsical whinny whiny whisking whoever whole
+whomever whoopee whooping whoops why wick widely widen widget widow width wieldable wielder wife
+wifi wikipedia wildcard wildcat wilder wildfire wildfowl wildland wildlife wildly wildness willed
+willfully willing willow willpower wilt wimp wince wincing wind wing winking winner winnings winter
+wipe wired wireless wiring wiry wisdom wise wish wisplike wispy wistful wizard wobble wobbling
+wobbly wok wolf wolverine womanhood womankind womanless womanlike womanly womb woof wooing wool
+woozy word work worried worrier worrisome worry worsening worshiper worst wound woven wow wrangle
+wrath wreath wreckage wrecker wrecking wrench wriggle wriggly wrinkle wrinkly wrist writing written
+wrongdoer wronged wrongful wrongly wrongness wrought xbox xerox yahoo yam yanking yapping yard yarn
+yeah yearbook yearling yearly yearning yeast yelling yelp yen yesterday yiddish yield yin yippee
+yo-yo yodel yoga yogurt yonder yoyo yummy zap zealous zebra zen zeppelin zero zestfully zesty
+zigzagged zipfile zipping zippy zips zit zodiac zombie zone zoning zookeeper zoologist zoology zoom
+`
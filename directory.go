@@ -0,0 +1,111 @@
+package zzdm
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//递归加密目录,目录结构保存在output中,NamingSecret时目录名同样使用确定性加密
+func (e *Encryptor) EncryptDir(ctx context.Context, input, output, password string) error {
+	if len(output) == 0 {
+		return ErrorOutputDir
+	}
+	if len(e.options.OutputFile) > 0 {
+		return ErrorOutputFile
+	}
+	var names *nameCipher
+	var err error
+	if e.options.Naming == NamingSecret {
+		names, err = newNameCipher(password)
+		if err != nil {
+			return err
+		}
+	}
+	return walkFiles(input, output, func(path string, dirs []string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if names != nil {
+			for i, dir := range dirs {
+				dirs[i] = names.Encode(dir)
+			}
+		}
+		target := filepath.Join(append([]string{output}, dirs...)...)
+		err := os.MkdirAll(target, 0755)
+		if err != nil {
+			return err
+		}
+		return e.encrypt(ctx, path, target, password, names)
+	})
+}
+
+//递归解密目录中的加密文件,无法解密的目录名保持不变
+func (d *Decryptor) DecryptDir(ctx context.Context, input, output, password string) error {
+	if len(output) == 0 {
+		return ErrorOutputDir
+	}
+	if len(d.options.OutputFile) > 0 {
+		return ErrorOutputFile
+	}
+	names, err := newNameCipher(password)
+	if err != nil {
+		return err
+	}
+	return walkFiles(input, output, func(path string, dirs []string) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if ext := filepath.Ext(path); ext != Extension && ext != AgeExtension && ext != OpenSSLExtension {
+			return nil
+		}
+		for i, dir := range dirs {
+			name, err := names.Decode(dir)
+			if err == nil {
+				dirs[i] = name
+			}
+		}
+		target := filepath.Join(append([]string{output}, dirs...)...)
+		err := os.MkdirAll(target, 0755)
+		if err != nil {
+			return err
+		}
+		return d.decrypt(ctx, path, target, password, names)
+	})
+}
+
+//遍历input下的普通文件,跳过output目录,dirs为文件相对input的上级目录
+func walkFiles(input, output string, visit func(path string, dirs []string) error) error {
+	root, err := filepath.Abs(input)
+	if err != nil {
+		return err
+	}
+	skip, err := filepath.Abs(output)
+	if err != nil {
+		return err
+	}
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path == skip {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		dirs := make([]string, 0)
+		if rel != "." {
+			dirs = strings.Split(rel, string(os.PathSeparator))
+		}
+		return visit(path, dirs)
+	})
+}
@@ -0,0 +1,190 @@
+package zzdm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+)
+
+//编辑临时明文文件,返回后读取文件的内容
+type EditFunc func(ctx context.Context, path string) error
+
+//存放临时明文的目录,优先使用内存文件系统,memory为false时明文会写入磁盘
+func PrivateTempDir() (dir string, memory bool) {
+	if runtime.GOOS == "linux" {
+		for _, dir := range []string{os.Getenv("XDG_RUNTIME_DIR"), "/dev/shm"} {
+			if len(dir) > 0 && IsDir(dir) {
+				return dir, true
+			}
+		}
+	}
+	return os.TempDir(), false
+}
+
+//解密到私有临时目录后调用edit,内容改变时按原文件头的设置重新加密并替换原文件,返回是否改变
+//沿用原有的主密钥、密钥槽与口令时间,份额依然有效;文件有签名时必须给出原签名者的私钥
+//临时目录中的全部文件在结束时粉碎,编辑器的交换与备份文件也包括在内
+func (e *Encryptor) Edit(ctx context.Context, input, password string, edit EditFunc) (bool, error) {
+	o := &e.options
+	file, err := os.Open(input)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	prefix := filePrefix(file)
+	if isAge(prefix) || isOpenSSL(prefix) {
+		return false, ErrorFormat
+	}
+	var reader io.Reader = file
+	armored := isArmored(prefix)
+	if armored {
+		reader, err = newArmorReader(bufio.NewReader(file), armorMessage)
+		if err != nil {
+			return false, err
+		}
+	}
+	header, err := ReadHead(reader)
+	if err != nil {
+		return false, err
+	}
+	if header == nil {
+		return false, ErrorFileIO
+	}
+	if header.Version < FormatVersion {
+		return false, ErrorEdit
+	}
+	keys, err := o.headerKeys(header, password)
+	if err != nil {
+		return false, err
+	}
+	meta, err := openMetadata(header, keys.data)
+	if err != nil {
+		return false, err
+	}
+	name, err := headerName(header, keys.data, password, nil)
+	if err != nil {
+		return false, err
+	}
+
+	base, _ := PrivateTempDir()
+	dir, err := ioutil.TempDir(base, "zzdm-edit-")
+	if err != nil {
+		return false, err
+	}
+	defer shredDir(dir, o)
+	//保留原文件名,编辑器可以按扩展名识别文件类型
+	path := filepath.Join(dir, filepath.Base(name))
+	plain, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return false, err
+	}
+	var signer ed25519.PublicKey
+	d := &Decryptor{options: *o}
+	d.options.Progress = nil
+	d.signed = func(digest []byte, trailer *Frame) error {
+		if trailer != nil {
+			signer = trailer.Signer
+		}
+		return (&Decryptor{options: d.options}).checkSignature(digest, trailer)
+	}
+	err = d.decryptFrames(ctx, reader, plain, header, keys, meta, newSignatureDigest(header), 0)
+	plain.Close()
+	if err != nil {
+		return false, err
+	}
+	//编辑后的文件由原签名者签名,没有签名的文件保持没有签名
+	if signer != nil && (o.SigningKey == nil || !bytes.Equal(o.SigningKey.Public().(ed25519.PublicKey), signer)) {
+		return false, ErrorEditSigned
+	}
+	before, err := fileDigest(path)
+	if err != nil {
+		return false, err
+	}
+	err = edit(ctx, path)
+	if err != nil {
+		return false, err
+	}
+	after, err := fileDigest(path)
+	if err != nil {
+		return false, err
+	}
+	if bytes.Equal(before, after) {
+		return false, nil
+	}
+
+	options := *o
+	options.Format = FormatZZDM
+	options.Cipher = header.Cipher
+	if len(options.Cipher) == 0 {
+		options.Cipher = CipherAES256CBC
+	}
+	options.FrameSize = header.FrameSize
+	if options.FrameSize <= 0 {
+		options.FrameSize = BUFFER
+	}
+	options.ParityData = int(header.ParityData)
+	options.ParityShards = int(header.ParityShards)
+	options.Naming = NamingOriginal
+	if header.Secret {
+		options.Naming = NamingSecret
+	}
+	options.Armor = armored
+	if signer == nil {
+		options.SigningKey = nil
+	}
+	//填充策略以元数据中记录的为准,没有记录时使用选项中的策略
+	if meta != nil && len(meta.PaddingPolicy) > 0 {
+		options.Padding, options.PaddingBucket = meta.PaddingPolicy, meta.PaddingBucket
+	}
+	key := &masterKey{key: keys.master, kdf: header.Kdf, salt: header.Salt, cost: int(header.Cost), slots: header.Slots, keyTime: header.KeyTime}
+	edited := &Metadata{}
+	if meta != nil {
+		*edited = *meta
+		edited.Mtime = time.Now().UnixNano()
+		edited.Atime = edited.Mtime
+	}
+	source, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer source.Close()
+	temp, err := ioutil.TempFile(filepath.Dir(input), ".zzdm-")
+	if err != nil {
+		return false, err
+	}
+	defer os.Remove(temp.Name())
+	defer temp.Close()
+	err = (&Encryptor{options}).encryptStream(ctx, source, temp, name, password, FileLength(path), edited, nil, key)
+	if err != nil {
+		return false, err
+	}
+	err = temp.Close()
+	if err != nil {
+		return false, err
+	}
+	if info, err := os.Stat(input); err == nil {
+		os.Chmod(temp.Name(), info.Mode())
+	}
+	o.Logger.Printf("edit %s", input)
+	return true, os.Rename(temp.Name(), input)
+}
+
+//粉碎目录中的全部文件后删除目录
+func shredDir(dir string, o *Options) {
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			if err = Shred(path, DefaultShredPasses); err != nil {
+				o.Logger.Printf("shred %s: %v", path, err)
+			}
+		}
+		return nil
+	})
+	os.RemoveAll(dir)
+}
@@ -0,0 +1,80 @@
+package zzdm
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestEdit(t *testing.T) {
+	dir := t.TempDir()
+	plain := testPlain(2500)
+	edited := append(testPlain(2500), "edited"...)
+	_, key, _ := ed25519.GenerateKey(nil)
+	_, other, _ := ed25519.GenerateKey(nil)
+	archive := filepath.Join(dir, "plain.scc")
+	options := []Option{WithCipher(CipherAES128CBC), WithFrameSize(1024), WithPadding(PaddingBucket, 3000), WithParity(2, 1), WithNaming(NamingSecret), WithSigningKey(key)}
+	original := encryptBytes(t, plain, options...)
+	appendEdit := func(ctx context.Context, path string) error {
+		return ioutil.WriteFile(path, edited, 0600)
+	}
+	//签名的文件只能由原签名者重新签名
+	for _, signing := range []ed25519.PrivateKey{nil, other} {
+		ioutil.WriteFile(archive, original, 0644)
+		_, err := NewEncryptor(WithSigningKey(signing)).Edit(context.Background(), archive, "password", appendEdit)
+		if !errors.Is(err, ErrorEditSigned) {
+			t.Fatalf("edit with a foreign key: %v", err)
+		}
+		assertContent(t, archive, original)
+	}
+	//编辑时的选项不影响文件原有的设置
+	changed, err := NewEncryptor(WithSigningKey(key), WithPadding(PaddingNone, 0), WithFrameSize(BUFFER)).Edit(context.Background(), archive, "password", appendEdit)
+	if err != nil || !changed {
+		t.Fatalf("edit: %v %v", changed, err)
+	}
+	data, _ := ioutil.ReadFile(archive)
+	var signer *Signer
+	if !bytes.Equal(decryptBytes(t, data, WithSigned(func(s *Signer) { signer = s })), edited) {
+		t.Fatal("plaintext mismatch")
+	}
+	if signer == nil || !bytes.Equal(signer.Key, key.Public().(ed25519.PublicKey)) {
+		t.Fatalf("signer: %v", signer)
+	}
+	header, _ := readRecords(t, data)
+	if header.Cipher != CipherAES128CBC || header.FrameSize != 1024 || header.ParityData != 2 || header.ParityShards != 1 || !header.Secret {
+		t.Fatalf("header: %v", header)
+	}
+	keys, _ := NewDecryptor().options.headerKeys(header, "password")
+	meta, _ := openMetadata(header, keys.data)
+	if meta.PaddingPolicy != PaddingBucket || meta.Length+meta.Padding != 3000 {
+		t.Fatalf("padding %s %d+%d", meta.PaddingPolicy, meta.Length, meta.Padding)
+	}
+	//没有签名的文件编辑后依然没有签名
+	ioutil.WriteFile(archive, encryptBytes(t, plain), 0644)
+	_, err = NewEncryptor(WithSigningKey(key)).Edit(context.Background(), archive, "password", appendEdit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ = ioutil.ReadFile(archive)
+	signer = nil
+	decryptBytes(t, data, WithSigned(func(s *Signer) { signer = s }))
+	if signer != nil {
+		t.Fatalf("unsigned file signed by %v", signer)
+	}
+	//内容没有改变时不重新加密
+	changed, err = NewEncryptor().Edit(context.Background(), archive, "password", func(ctx context.Context, path string) error {
+		return nil
+	})
+	if err != nil || changed {
+		t.Fatalf("unchanged edit: %v %v", changed, err)
+	}
+	assertContent(t, archive, data)
+	leftover, _ := filepath.Glob(filepath.Join(dir, ".zzdm-*"))
+	if len(leftover) > 0 {
+		t.Fatalf("temporary files left: %v", leftover)
+	}
+}
@@ -0,0 +1,772 @@
+package zzdm
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"fmt"
+	"hash/adler32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+//加密器
+type Encryptor struct {
+	options Options
+}
+
+//解密器
+type Decryptor struct {
+	options Options
+	//不为nil时代替签名校验,用于重新签名
+	signed func(digest []byte, trailer *Frame) error
+}
+
+func NewEncryptor(options ...Option) *Encryptor {
+	return &Encryptor{newOptions(options)}
+}
+
+func NewDecryptor(options ...Option) *Decryptor {
+	return &Decryptor{options: newOptions(options)}
+}
+
+//加密文件,ctx取消时在帧之间停止并删除未完成的输出文件
+func (e *Encryptor) Encrypt(ctx context.Context, input, output, password string) error {
+	var names *nameCipher
+	if e.options.Naming == NamingSecret {
+		var err error
+		names, err = newNameCipher(password)
+		if err != nil {
+			return err
+		}
+	}
+	return e.encrypt(ctx, input, output, password, names)
+}
+
+func (e *Encryptor) encrypt(ctx context.Context, input, output, password string, names *nameCipher) error {
+	return e.options.report(ctx, OperationEncrypt, input, func(ctx context.Context) error {
+		//无法校验输出时在加密之前报错
+		if e.options.RemoveSource && !e.options.canCheckOutput() {
+			return ErrorRemoveSource
+		}
+		err := e.encryptFile(ctx, input, output, password, names)
+		if err != nil || !e.options.RemoveSource {
+			return err
+		}
+		return e.removeSource(ctx, input, password)
+	})
+}
+
+func (e *Encryptor) encryptFile(ctx context.Context, input, output, password string, names *nameCipher) error {
+	o := &e.options
+	err := o.checkSigning()
+	if err != nil {
+		return err
+	}
+	switch o.Format {
+	case FormatAge:
+		return e.encryptAge(ctx, input, output, password)
+	case FormatOpenSSL:
+		return e.encryptOpenSSL(ctx, input, output, password)
+	case FormatZZDM, "":
+	default:
+		return ErrorFormat
+	}
+	fileName := encryptionName(input, output, names)
+	if len(o.OutputFile) > 0 {
+		fileName = o.OutputFile
+	}
+	reportOutput(ctx, fileName, FormatZZDM, o.Cipher)
+	//输入文件和输出文件不能相同
+	//这里忽略文件大小写,对于某些系统可能会存在误判
+	if strings.EqualFold(fileName, input) {
+		return ErrorFileName
+	}
+	//文本格式的输出无法续传,份额在续传时无法重新生成
+	rejected := false
+	if o.Resume && !o.Armor && o.Shares <= 0 && Exist(fileName) {
+		resumed, err := e.resume(ctx, input, fileName, password)
+		if resumed {
+			return err
+		}
+		rejected = true
+	}
+	key, err := o.newMasterKey(password)
+	if err != nil {
+		return err
+	}
+	raw, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer raw.Close()
+	ptr, err := createOutput(ctx, fileName, input, o.Overwrite)
+	if err != nil || ptr == nil {
+		return resumeConflict(err, rejected)
+	}
+	defer ptr.Close()
+	fileName = ptr.Name()
+	//份额先于密文写入,写入失败时不保留只能由份额解密的输出
+	shares, err := key.writeShares(fileName, o.Overwrite)
+	if err != nil {
+		ptr.Close()
+		os.Remove(fileName)
+		return err
+	}
+	meta := &Metadata{}
+	if o.Preserve {
+		meta, err = fileMetadata(input)
+	}
+	if err == nil {
+		o.Logger.Printf("encrypt %s -> %s", input, fileName)
+		err = e.encryptStream(ctx, raw, ptr, filepath.Base(input), password, FileLength(input), meta, names, key)
+	}
+	//有份额的输出无法续传,失败时连同份额一起删除
+	if err != nil && (len(shares) > 0 || ctx.Err() != nil && !o.Resume) {
+		ptr.Close()
+		os.Remove(fileName)
+		removeFiles(shares)
+		o.Logger.Printf("encryption stopped: %v, %s removed", err, fileName)
+	}
+	return err
+}
+
+//加密数据流,size为明文长度,name为写入文件头的文件名
+func (e *Encryptor) EncryptStream(ctx context.Context, reader io.Reader, writer io.Writer, name, password string, size int64) error {
+	err := e.options.checkSigning()
+	if err != nil {
+		return err
+	}
+	switch e.options.Format {
+	case FormatAge:
+		return e.encryptAgeStream(ctx, reader, writer, password)
+	case FormatOpenSSL:
+		return e.encryptOpenSSLStream(ctx, reader, writer, password)
+	case FormatZZDM, "":
+	default:
+		return ErrorFormat
+	}
+	//份额只能在加密文件时写入
+	if e.options.Shares > 0 {
+		return ErrorShareOutput
+	}
+	return e.encryptStream(ctx, reader, writer, name, password, size, &Metadata{}, nil, nil)
+}
+
+//加密数据流,meta中的长度与填充由这里填写,names为nil时按需派生文件名密钥
+//key为nil时由口令派生主密钥
+func (e *Encryptor) encryptStream(ctx context.Context, reader io.Reader, writer io.Writer, name, password string, size int64, meta *Metadata, names *nameCipher, key *masterKey) error {
+	o := &e.options
+	frameSize := o.FrameSize
+	padded, err := paddedLength(size, o.Padding, o.PaddingBucket)
+	if err != nil {
+		return err
+	}
+	err = checkParity(o.ParityData, o.ParityShards)
+	if err != nil {
+		return err
+	}
+	left := padded % frameSize
+	frameCount := (padded - left) / frameSize
+	if left > 0 {
+		frameCount++
+	}
+	if key == nil {
+		key, err = o.newMasterKey(password)
+		if err != nil {
+			return err
+		}
+	}
+	nonce, err := randomIv()
+	if err != nil {
+		return err
+	}
+	secret := o.Naming == NamingSecret
+	nameBytes := []byte(name)
+	naming := ""
+	if secret {
+		if names == nil {
+			names, err = newNameCipher(password)
+			if err != nil {
+				return err
+			}
+		}
+		nameBytes = names.seal(nameBytes)
+		naming = NamingSIV
+	}
+	header := &Header{
+		Frames:    frameCount,
+		Name:      nameBytes,
+		Secret:    secret,
+		Cipher:    o.Cipher,
+		Kdf:       key.kdf,
+		Salt:      key.salt,
+		FrameSize: frameSize,
+		Naming:    naming,
+		Version:   FormatVersion,
+		Nonce:     nonce,
+		Slots:     key.slots,
+		//口令策略按这个时间检查口令的使用期限
+		KeyTime: time.Now().Unix(),
+	}
+	if key.keyTime != 0 {
+		header.KeyTime = key.keyTime
+	}
+	if len(key.salt) > 0 {
+		header.Cost = int32(key.cost)
+	}
+	if o.ParityData > 0 {
+		header.ParityData = int32(o.ParityData)
+		header.ParityShards = int32(o.ParityShards)
+	}
+	keys, err := newFileKeys(header, key.key)
+	if err != nil {
+		return err
+	}
+	//填充的0和数据一起加密,原始长度记录在加密的元数据中
+	meta.Length = size
+	meta.Padding = padded - size
+	//追加与编辑时按文件原有的策略重新填充
+	meta.PaddingPolicy, meta.PaddingBucket = o.Padding, 0
+	if o.Padding == PaddingBucket {
+		meta.PaddingBucket = o.PaddingBucket
+	}
+	header.Meta, err = sealMetadata(meta, keys.data)
+	if err != nil {
+		return err
+	}
+	header.Mac, err = keys.headerMac(header)
+	if err != nil {
+		return err
+	}
+	source := &countReader{reader: reader}
+	reader = source
+	if padded != size {
+		reader = io.MultiReader(io.LimitReader(source, size), &zeroReader{padded - size})
+	}
+	var armor *armorWriter
+	if o.Armor {
+		armor, err = newArmorWriter(writer, armorMessage)
+		if err != nil {
+			return err
+		}
+		writer = armor
+	}
+	err = writeHeader(writer, header)
+	if err != nil {
+		return err
+	}
+	var digest *signatureDigest
+	if o.SigningKey != nil {
+		digest = newSignatureDigest(header)
+	}
+	err = e.encryptFrames(ctx, reader, writer, header, keys, digest, 0)
+	if err != nil {
+		return err
+	}
+	if source.count < size {
+		return ErrorDataMissing
+	}
+	if digest != nil {
+		err = writeFrame(writer, signatureFrame(o.SigningKey, digest.sum()))
+		if err != nil {
+			return err
+		}
+	}
+	if armor != nil {
+		return armor.Close()
+	}
+	return nil
+}
+
+//从第start帧开始逐帧加密,start必须位于校验分组的边界,digest不为nil时记录帧的认证码用于签名
+func (e *Encryptor) encryptFrames(ctx context.Context, reader io.Reader, writer io.Writer, header *Header, keys *fileKeys, digest *signatureDigest, start int64) error {
+	o := &e.options
+	frameSize := header.FrameSize
+	frameCount := header.Frames
+	parityData := int(header.ParityData)
+	var group *parityGroup
+	if parityData > 0 {
+		group = &parityGroup{keys: keys, shards: int(header.ParityShards), index: start / int64(parityData)}
+	}
+	index := start
+	for index < frameCount {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		//每批读取Concurrency帧并发加密,再按顺序写入
+		batch := make([]*frameTask, 0, o.Concurrency)
+		eof := false
+		for len(batch) < o.Concurrency && index+int64(len(batch)) < frameCount {
+			buffer := make([]byte, frameSize)
+			num, err := io.ReadFull(reader, buffer)
+			if err == io.EOF {
+				eof = true
+				break
+			}
+			if err != nil && err != io.ErrUnexpectedEOF {
+				return err
+			}
+			batch = append(batch, &frameTask{index: index + int64(len(batch)), plain: buffer[:num]})
+			if err == io.ErrUnexpectedEOF {
+				eof = true
+				break
+			}
+		}
+		parallel(len(batch), func(i int) {
+			batch[i].seal(keys)
+		})
+		for _, task := range batch {
+			if task.err != nil {
+				return task.err
+			}
+			record, err := frameRecord(&Frame{Iv: task.iv, Data: task.data, Hash: task.checksum, Mac: task.mac})
+			if err != nil {
+				return err
+			}
+			_, err = writer.Write(record)
+			if err != nil {
+				return err
+			}
+			if digest != nil {
+				digest.add(task.mac)
+			}
+			index++
+			if group != nil {
+				group.add(record)
+				if len(group.records) == parityData || index == frameCount {
+					err = group.flush(writer)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			o.progress(ctx, index, frameCount, len(task.plain))
+		}
+		if eof {
+			break
+		}
+	}
+	return nil
+}
+
+//解密文件,ctx取消时在帧之间停止并删除未完成的输出文件
+func (d *Decryptor) Decrypt(ctx context.Context, input, output, password string) error {
+	return d.decrypt(ctx, input, output, password, nil)
+}
+
+func (d *Decryptor) decrypt(ctx context.Context, input, output, password string, names *nameCipher) error {
+	return d.options.report(ctx, OperationDecrypt, input, func(ctx context.Context) error {
+		return d.decryptFile(ctx, input, output, password, names)
+	})
+}
+
+func (d *Decryptor) decryptFile(ctx context.Context, input, output, password string, names *nameCipher) error {
+	o := &d.options
+	file, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	//自动识别age、openssl格式与文本格式的输入
+	prefix := filePrefix(file)
+	if o.Format == FormatAge || isAge(prefix) {
+		return d.decryptAge(ctx, file, input, output, password)
+	}
+	if o.Format == FormatOpenSSL || isOpenSSL(prefix) {
+		return d.decryptOpenSSL(ctx, file, input, output, password)
+	}
+	var reader io.Reader = file
+	armored := isArmored(prefix)
+	if armored {
+		reader, err = newArmorReader(bufio.NewReader(file), armorMessage)
+		if err != nil {
+			return err
+		}
+	}
+	header, err := ReadHead(reader)
+	if err != nil {
+		return err
+	}
+	if header == nil {
+		return ErrorFileIO
+	}
+	keys, err := o.headerKeys(header, password)
+	if err != nil {
+		return err
+	}
+	meta, err := openMetadata(header, keys.data)
+	if err != nil {
+		return err
+	}
+	fileName, err := headerName(header, keys.data, password, names)
+	if err != nil {
+		return err
+	}
+	fullName := decryptionName(input, output, fileName)
+	if len(o.OutputFile) > 0 {
+		fullName = o.OutputFile
+	}
+	cipher := header.Cipher
+	if len(cipher) == 0 {
+		cipher = CipherAES256CBC
+	}
+	reportOutput(ctx, fullName, FormatZZDM, cipher)
+	//输入文件和输出文件不能相同
+	//这里忽略文件大小写,对于某些系统可能会存在误判
+	if strings.EqualFold(fullName, input) {
+		return ErrorFileName
+	}
+	rejected := false
+	if o.Resume && !armored && Exist(fullName) {
+		resumed, err := d.resume(ctx, file, fullName, header, keys, meta)
+		if resumed {
+			if err == nil && o.Preserve && meta != nil {
+				return restoreMetadata(fullName, meta, o.Logger)
+			}
+			return err
+		}
+		rejected = true
+	}
+	ptr, err := createOutput(ctx, fullName, input, o.Overwrite)
+	if err != nil || ptr == nil {
+		return resumeConflict(err, rejected)
+	}
+	defer ptr.Close()
+	fullName = ptr.Name()
+	o.Logger.Printf("decrypt %s -> %s", input, fullName)
+	err = d.decryptFrames(ctx, reader, ptr, header, keys, meta, newSignatureDigest(header), 0)
+	if err != nil {
+		if ctx.Err() != nil && !o.Resume {
+			ptr.Close()
+			os.Remove(fullName)
+			o.Logger.Printf("decryption cancelled, %s removed", fullName)
+		}
+		return err
+	}
+	if o.Preserve && meta != nil {
+		//先关闭文件,避免之后的写入改变修改时间
+		ptr.Close()
+		return restoreMetadata(fullName, meta, o.Logger)
+	}
+	return nil
+}
+
+//解密数据流,ctx取消时在帧之间停止
+func (d *Decryptor) DecryptStream(ctx context.Context, reader io.Reader, writer io.Writer, password string) error {
+	buffered := bufio.NewReader(reader)
+	prefix, _ := buffered.Peek(512)
+	if d.options.Format == FormatAge || isAge(prefix) {
+		return d.decryptAgeStream(ctx, buffered, writer, password)
+	}
+	if d.options.Format == FormatOpenSSL || isOpenSSL(prefix) {
+		return d.decryptOpenSSLStream(ctx, buffered, writer, password)
+	}
+	reader, err := dearmor(buffered)
+	if err != nil {
+		return err
+	}
+	header, err := ReadHead(reader)
+	if err != nil {
+		return err
+	}
+	if header == nil {
+		return ErrorFileIO
+	}
+	keys, err := d.options.headerKeys(header, password)
+	if err != nil {
+		return err
+	}
+	meta, err := openMetadata(header, keys.data)
+	if err != nil {
+		return err
+	}
+	return d.decryptFrames(ctx, reader, writer, header, keys, meta, newSignatureDigest(header), 0)
+}
+
+//校验加密文件,解密全部帧但不写入输出
+func (d *Decryptor) Verify(ctx context.Context, input, password string) error {
+	file, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return d.DecryptStream(ctx, file, ioutil.Discard, password)
+}
+
+//逐帧解密,有元数据时去掉末尾的填充
+//start为已经解密的帧数,reader需要位于第start帧之前,digest中需要已经包含这些帧的认证码
+func (d *Decryptor) decryptFrames(ctx context.Context, reader io.Reader, writer io.Writer, header *Header, keys *fileKeys, meta *Metadata, digest *signatureDigest, start int64) error {
+	o := &d.options
+	var padding *paddingWriter
+	if meta != nil {
+		left := meta.Length - start*frameLength(header)
+		if left < 0 {
+			left = 0
+		}
+		padding = &paddingWriter{writer: writer, left: left}
+		writer = padding
+	}
+	frameCount := header.Frames
+	index := start
+	//签名记录只能位于最后
+	var trailer *Frame
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		batch := make([]*frameTask, 0, o.Concurrency)
+		eof := false
+		for len(batch) < o.Concurrency {
+			frame, err := ReadFrame(reader)
+			if err != nil {
+				if err == io.EOF {
+					eof = true
+					break
+				}
+				return err
+			}
+			if trailer != nil {
+				return ErrorSignature
+			}
+			if len(frame.Signature) > 0 {
+				trailer = frame
+				continue
+			}
+			//校验帧只用于修复
+			if len(frame.Parity) > 0 {
+				continue
+			}
+			batch = append(batch, &frameTask{index: index + int64(len(batch)), iv: frame.Iv, data: frame.Data, checksum: frame.Hash, mac: frame.Mac})
+		}
+		parallel(len(batch), func(i int) {
+			batch[i].open(keys)
+		})
+		for _, task := range batch {
+			if task.err != nil {
+				return task.err
+			}
+			size, err := writer.Write(task.plain)
+			if err != nil {
+				return err
+			}
+			if size != len(task.plain) {
+				return ErrorDataMissing
+			}
+			digest.add(task.mac)
+			index++
+			o.progress(ctx, index, frameCount, size)
+		}
+		if eof {
+			break
+		}
+	}
+	if index != frameCount {
+		return ErrorFrameMissing
+	}
+	if padding != nil && padding.left != 0 {
+		return ErrorDataMissing
+	}
+	return d.checkSignature(digest.sum(), trailer)
+}
+
+//文件头中记录的原始文件名,names为nil时按需派生文件名密钥
+func headerName(header *Header, key []byte, password string, names *nameCipher) (string, error) {
+	var err error
+	nameBytes := header.Name
+	if header.Secret && header.Naming == NamingSIV {
+		if names == nil {
+			names, err = newNameCipher(password)
+			if err != nil {
+				return "", err
+			}
+		}
+		nameBytes, err = names.open(nameBytes)
+		if err != nil {
+			return "", err
+		}
+	} else if header.Secret {
+		//旧版本使用固定向量的CBC加密文件名
+		nameBytes, err = AesDecrypt(nameBytes, key, defaultIv())
+		if err != nil {
+			return "", err
+		}
+		if nameBytes == nil {
+			return "", ErrorAES
+		}
+	}
+	fileName := string(nameBytes)
+	if len(fileName) <= 0 {
+		return "", ErrorFileIO
+	}
+	return fileName, nil
+}
+
+//以O_EXCL创建输出文件,已存在或者属于同一批中的其他输入时按处理策略处理,跳过时返回nil
+//批量处理时整个过程持有锁,创建与登记之间不会有其他任务选到同一个文件
+func createOutput(ctx context.Context, fileName, source string, overwrite int) (*os.File, error) {
+	result := contextResult(ctx)
+	task := contextTask(ctx)
+	if task != nil {
+		task.outputs.mutex.Lock()
+		defer task.outputs.mutex.Unlock()
+	}
+	taken := task.taken(fileName)
+	if !taken {
+		file, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0666)
+		if err == nil {
+			task.claim(fileName)
+			return file, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+	}
+	switch overwrite {
+	case OverwriteForce, OverwriteNewer:
+		//不能覆盖同一批中其他输入正在写入的文件
+		if taken {
+			return nil, ErrorOutputDuplicated
+		}
+		if overwrite == OverwriteForce || newer(source, fileName) {
+			file, err := os.OpenFile(fileName, os.O_RDWR|os.O_TRUNC, 0)
+			if err == nil {
+				task.claim(fileName)
+			}
+			return file, err
+		}
+		fallthrough
+	case OverwriteSkip:
+		if result != nil {
+			result.Skipped = true
+		}
+		return nil, nil
+	case OverwriteRename:
+		extension := filepath.Ext(fileName)
+		base := strings.TrimSuffix(fileName, extension)
+		for i := 1; ; i++ {
+			name := fmt.Sprintf("%s (%d)%s", base, i, extension)
+			if task.taken(name) {
+				continue
+			}
+			file, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0666)
+			if os.IsExist(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			task.claim(name)
+			if result != nil {
+				result.Output = name
+			}
+			return file, nil
+		}
+	}
+	if taken {
+		return nil, ErrorOutputDuplicated
+	}
+	return nil, ErrorFileDuplicated
+}
+
+//source的修改时间是否晚于target
+func newer(source, target string) bool {
+	sourceStat, err := os.Stat(source)
+	if err != nil {
+		return false
+	}
+	targetStat, err := os.Stat(target)
+	if err != nil {
+		return true
+	}
+	return sourceStat.ModTime().After(targetStat.ModTime())
+}
+
+//按覆盖策略处理已存在的输出文件,只区分覆盖与报错,其他策略都按OverwriteNever处理
+func prepareOutput(fileName string, overwrite int) error {
+	if !Exist(fileName) {
+		return nil
+	}
+	if overwrite == OverwriteForce {
+		return os.Truncate(fileName, 0)
+	}
+	return ErrorFileDuplicated
+}
+
+//单帧的加解密任务
+type frameTask struct {
+	index    int64
+	plain    []byte
+	iv       []byte
+	data     []byte
+	checksum uint32
+	mac      []byte
+	err      error
+}
+
+//加密一帧,帧向量随机生成并加密后保存
+func (t *frameTask) seal(keys *fileKeys) {
+	t.checksum = adler32.Checksum(t.plain)
+	iv, err := randomIv()
+	if err != nil {
+		t.err = err
+		return
+	}
+	t.iv, t.err = AesEncrypt(iv, keys.iv, keys.nonce)
+	if t.err != nil {
+		return
+	}
+	t.data, t.err = AesEncrypt(t.plain, keys.data, iv)
+	if t.err != nil {
+		return
+	}
+	t.mac = keys.frameMac(t.index, t.iv, t.data)
+}
+
+//校验并解密一帧,旧格式没有认证码
+func (t *frameTask) open(keys *fileKeys) {
+	if t.iv == nil || t.data == nil {
+		t.err = ErrorFileIO
+		return
+	}
+	if keys.mac != nil && !hmac.Equal(t.mac, keys.frameMac(t.index, t.iv, t.data)) {
+		t.err = ErrorAuthentication
+		return
+	}
+	iv, err := AesDecrypt(t.iv, keys.iv, keys.nonce)
+	if err != nil {
+		t.err = err
+		return
+	}
+	t.plain, t.err = AesDecrypt(t.data, keys.data, iv)
+	if t.err != nil {
+		return
+	}
+	if adler32.Checksum(t.plain) != t.checksum {
+		t.err = ErrorChecksumMismatch
+	}
+}
+
+//并发执行count个任务并等待全部完成
+func parallel(count int, task func(index int)) {
+	if count == 1 {
+		task(0)
+		return
+	}
+	var group sync.WaitGroup
+	for i := 0; i < count; i++ {
+		group.Add(1)
+		go func(index int) {
+			defer group.Done()
+			task(index)
+		}(i)
+	}
+	group.Wait()
+}
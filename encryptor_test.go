@@ -0,0 +1,210 @@
+package zzdm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+//旧格式的测试文件由之前的版本加密,口令为legacy-password,共3帧
+const legacyPassword = "legacy-password"
+
+func TestDecryptVersion1(t *testing.T) {
+	cases := []struct {
+		input, plain, password string
+	}{
+		{"demo/40ba2ffbecd3fee3e77fa553985f4c73.scc", "demo/test.txt", "1234567890"},
+		{"testdata/v1/multi.scc", "testdata/v1/multi.txt", legacyPassword},
+	}
+	for _, c := range cases {
+		output := t.TempDir()
+		err := NewDecryptor().Decrypt(context.Background(), c.input, output, c.password)
+		if err != nil {
+			t.Fatalf("%s: %v", c.input, err)
+		}
+		assertSameFile(t, c.plain, filepath.Join(output, filepath.Base(c.plain)))
+		err = NewDecryptor().Verify(context.Background(), c.input, "wrong password")
+		if err == nil {
+			t.Fatalf("%s: wrong password accepted", c.input)
+		}
+	}
+}
+
+func TestRoundTripVersion2(t *testing.T) {
+	plain := testPlain(10000)
+	for _, size := range []int64{1, 4096, 10000, 20000} {
+		encrypted := encryptBytes(t, plain, WithFrameSize(size))
+		header, _ := readRecords(t, encrypted)
+		if header.Version != FormatVersion {
+			t.Fatalf("version %d", header.Version)
+		}
+		if got := decryptBytes(t, encrypted); !bytes.Equal(got, plain) {
+			t.Fatalf("frame size %d: plaintext mismatch", size)
+		}
+	}
+	//旧格式的文件转换为当前格式后内容不变
+	dir := t.TempDir()
+	legacy := filepath.Join(dir, "multi.scc")
+	copyFile(t, "testdata/v1/multi.scc", legacy)
+	err := NewEncryptor().Migrate(context.Background(), legacy, legacyPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := ioutil.ReadFile(legacy)
+	if header, _ := readRecords(t, data); header.Version != FormatVersion {
+		t.Fatalf("migrated version %d", header.Version)
+	}
+	var got bytes.Buffer
+	err = NewDecryptor().DecryptStream(context.Background(), bytes.NewReader(data), &got, legacyPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, _ := ioutil.ReadFile("testdata/v1/multi.txt")
+	if !bytes.Equal(got.Bytes(), expected) {
+		t.Fatal("migrated plaintext mismatch")
+	}
+}
+
+func TestTamperedVersion2(t *testing.T) {
+	encrypted := encryptBytes(t, testPlain(10000), WithFrameSize(4096))
+	cases := []struct {
+		name   string
+		change func(header *Header, frames []*Frame) (*Header, []*Frame)
+		err    error
+	}{
+		{"header frame size", func(header *Header, frames []*Frame) (*Header, []*Frame) {
+			header.FrameSize = 2048
+			return header, frames
+		}, ErrorAuthentication},
+		{"header name", func(header *Header, frames []*Frame) (*Header, []*Frame) {
+			header.Name = []byte("other.txt")
+			return header, frames
+		}, ErrorAuthentication},
+		{"reordered frames", func(header *Header, frames []*Frame) (*Header, []*Frame) {
+			frames[0], frames[1] = frames[1], frames[0]
+			return header, frames
+		}, ErrorAuthentication},
+		{"frame data", func(header *Header, frames []*Frame) (*Header, []*Frame) {
+			frames[1].Data[0] ^= 1
+			return header, frames
+		}, ErrorAuthentication},
+		{"truncated", func(header *Header, frames []*Frame) (*Header, []*Frame) {
+			return header, frames[:len(frames)-1]
+		}, ErrorFrameMissing},
+		{"frame from another file", func(header *Header, frames []*Frame) (*Header, []*Frame) {
+			_, others := readRecords(t, encryptBytes(t, testPlain(10000), WithFrameSize(4096)))
+			frames[0] = others[0]
+			return header, frames
+		}, ErrorAuthentication},
+	}
+	for _, c := range cases {
+		header, frames := readRecords(t, encrypted)
+		header, frames = c.change(header, frames)
+		var buffer bytes.Buffer
+		writeRecords(t, &buffer, header, frames)
+		err := NewDecryptor().DecryptStream(context.Background(), &buffer, ioutil.Discard, "password")
+		if !errors.Is(err, c.err) {
+			t.Errorf("%s: got %v, want %v", c.name, err, c.err)
+		}
+	}
+	//截断在帧的中间
+	err := NewDecryptor().DecryptStream(context.Background(), bytes.NewReader(encrypted[:len(encrypted)-10]), ioutil.Discard, "password")
+	if err == nil {
+		t.Error("truncated frame accepted")
+	}
+}
+
+//可重复的测试明文
+func testPlain(size int) []byte {
+	plain := make([]byte, size)
+	for i := range plain {
+		plain[i] = byte(i*7 + i/251)
+	}
+	return plain
+}
+
+func encryptBytes(t *testing.T, plain []byte, options ...Option) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	options = append([]Option{WithKDF(KDFPBKDF2, 1000)}, options...)
+	err := NewEncryptor(options...).EncryptStream(context.Background(), bytes.NewReader(plain), &buffer, "plain.txt", "password", int64(len(plain)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func decryptBytes(t *testing.T, encrypted []byte, options ...Option) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	err := NewDecryptor(options...).DecryptStream(context.Background(), bytes.NewReader(encrypted), &buffer, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func readRecords(t *testing.T, data []byte) (*Header, []*Frame) {
+	t.Helper()
+	reader := bytes.NewReader(data)
+	header, err := ReadHead(reader)
+	if err != nil || header == nil {
+		t.Fatalf("header: %v", err)
+	}
+	var frames []*Frame
+	for {
+		frame, err := ReadFrame(reader)
+		if err == io.EOF {
+			return header, frames
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		frames = append(frames, frame)
+	}
+}
+
+func writeRecords(t *testing.T, writer io.Writer, header *Header, frames []*Frame) {
+	t.Helper()
+	err := writeHeader(writer, header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, frame := range frames {
+		err = writeFrame(writer, frame)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func copyFile(t *testing.T, source, target string) {
+	t.Helper()
+	data, err := ioutil.ReadFile(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(target, data, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertSameFile(t *testing.T, expected, actual string) {
+	t.Helper()
+	a, err := ioutil.ReadFile(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(a, b) {
+		t.Fatalf("%s and %s differ", expected, actual)
+	}
+}
@@ -0,0 +1,109 @@
+package zzdm
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	//zzdm自己的文件格式
+	FormatZZDM = "zzdm"
+	//age v1文件格式,口令使用scrypt,公钥使用X25519
+	FormatAge = "age"
+	//openssl enc的Salted__格式
+	FormatOpenSSL = "openssl"
+)
+
+//其他格式的加解密数据流
+type streamFunc func(ctx context.Context, reader io.Reader, writer io.Writer, password string) error
+
+//在读取之间检查ctx是否已经取消
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.reader.Read(p)
+}
+
+//以其他格式加密文件,输出文件名为原文件名加上extension
+func (e *Encryptor) encryptFormat(ctx context.Context, input, output, password, extension string, stream streamFunc) error {
+	o := &e.options
+	fileName := formatEncryptionName(input, output, extension)
+	if len(o.OutputFile) > 0 {
+		fileName = o.OutputFile
+	}
+	format, cipher := formatCipher(extension, o)
+	reportOutput(ctx, fileName, format, cipher)
+	raw, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer raw.Close()
+	ptr, err := createOutput(ctx, fileName, input, o.Overwrite)
+	if err != nil || ptr == nil {
+		return err
+	}
+	defer ptr.Close()
+	fileName = ptr.Name()
+	o.Logger.Printf("encrypt %s -> %s", input, fileName)
+	err = stream(ctx, raw, ptr, password)
+	if err != nil && ctx.Err() != nil {
+		ptr.Close()
+		os.Remove(fileName)
+		o.Logger.Printf("encryption cancelled, %s removed", fileName)
+	}
+	return err
+}
+
+//解密其他格式的文件,输出文件名为去掉extension的原文件名
+func (d *Decryptor) decryptFormat(ctx context.Context, file *os.File, input, output, password, extension string, stream streamFunc) error {
+	o := &d.options
+	fullName := formatDecryptionName(input, output, extension)
+	if len(o.OutputFile) > 0 {
+		fullName = o.OutputFile
+	}
+	format, cipher := formatCipher(extension, o)
+	reportOutput(ctx, fullName, format, cipher)
+	ptr, err := createOutput(ctx, fullName, input, o.Overwrite)
+	if err != nil || ptr == nil {
+		return err
+	}
+	defer ptr.Close()
+	fullName = ptr.Name()
+	o.Logger.Printf("decrypt %s -> %s", input, fullName)
+	err = stream(ctx, file, ptr, password)
+	if err != nil {
+		//这些格式在认证或填充校验失败前已经输出了部分明文,不保留
+		ptr.Close()
+		os.Remove(fullName)
+	}
+	return err
+}
+
+//以其他格式加密的输出文件名为原文件名加上extension
+func formatEncryptionName(input, output, extension string) string {
+	dir := filepath.Dir(input)
+	if IsDir(output) {
+		dir = output
+	}
+	return filepath.Join(dir, filepath.Base(input)+extension)
+}
+
+//解密其他格式的输出文件名为去掉extension的原文件名,没有extension时加上.out
+func formatDecryptionName(input, output, extension string) string {
+	name := filepath.Base(input)
+	if strings.HasSuffix(name, extension) && len(name) > len(extension) {
+		name = strings.TrimSuffix(name, extension)
+	} else {
+		name += ".out"
+	}
+	return decryptionName(input, output, name)
+}
@@ -0,0 +1,159 @@
+package zzdm
+
+import (
+	"crypto/rand"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+const (
+	//口令短语的默认词数,约77比特
+	DefaultPassphraseWords = 6
+	//字符口令的默认长度
+	DefaultPasswordLength = 20
+	//生成的口令的最大长度
+	maxGeneratedLength = 1024
+)
+
+//各类别的字符,符号中去掉了引号、反斜杠与空格,方便在命令行中使用
+var classAlphabets = map[string]string{
+	ClassUpper:  "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	ClassLower:  "abcdefghijklmnopqrstuvwxyz",
+	ClassDigit:  "0123456789",
+	ClassSymbol: "!#$%&()*+,-./:;<=>?@[]^_{|}~",
+}
+
+var (
+	dicewareOnce  sync.Once
+	dicewareWords []string
+)
+
+//生成的口令
+type GeneratedPassword struct {
+	Password string `json:"password"`
+	//均匀随机生成时的熵,单位为比特
+	Entropy float64 `json:"entropy"`
+}
+
+//[0,n)中均匀分布的随机数
+func randomIndex(n int) (int, error) {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(index.Int64()), nil
+}
+
+//从内置的diceware词表中随机选出words个词,以separator连接
+func GeneratePassphrase(words int, separator string) (*GeneratedPassword, error) {
+	if words <= 0 || words > maxGeneratedLength {
+		return nil, ErrorGenerate
+	}
+	dicewareOnce.Do(func() {
+		dicewareWords = strings.Fields(dicewareList)
+	})
+	chosen := make([]string, words)
+	for i := range chosen {
+		index, err := randomIndex(len(dicewareWords))
+		if err != nil {
+			return nil, err
+		}
+		chosen[i] = dicewareWords[index]
+	}
+	return &GeneratedPassword{
+		Password: strings.Join(chosen, separator),
+		Entropy:  float64(words) * math.Log2(float64(len(dicewareWords))),
+	}, nil
+}
+
+//由指定类别的字符随机生成length个字符的口令,没有指定类别时使用全部类别
+//长度足够时每个类别至少出现一次,不满足的结果丢弃重新生成
+func GeneratePassword(length int, classes ...string) (*GeneratedPassword, error) {
+	if length <= 0 || length > maxGeneratedLength {
+		return nil, ErrorGenerate
+	}
+	if len(classes) == 0 {
+		classes = []string{ClassUpper, ClassLower, ClassDigit, ClassSymbol}
+	}
+	alphabets := make([]string, 0, len(classes))
+	for _, class := range classes {
+		alphabet, ok := classAlphabets[class]
+		if !ok {
+			return nil, ErrorPolicyClass
+		}
+		if !strings.Contains(strings.Join(alphabets, ""), alphabet) {
+			alphabets = append(alphabets, alphabet)
+		}
+	}
+	alphabet := []rune(strings.Join(alphabets, ""))
+	every := length >= len(alphabets)
+	password := make([]rune, length)
+	for {
+		for i := range password {
+			index, err := randomIndex(len(alphabet))
+			if err != nil {
+				return nil, err
+			}
+			password[i] = alphabet[index]
+		}
+		if !every || containsClasses(string(password), alphabets) {
+			break
+		}
+	}
+	return &GeneratedPassword{Password: string(password), Entropy: passwordEntropy(length, alphabets, every)}, nil
+}
+
+func containsClasses(password string, alphabets []string) bool {
+	for _, alphabet := range alphabets {
+		if !strings.ContainsAny(password, alphabet) {
+			return false
+		}
+	}
+	return true
+}
+
+//所有可能结果数量以2为底的对数,每个类别都要出现时用容斥原理计数
+func passwordEntropy(length int, alphabets []string, every bool) float64 {
+	total := 0
+	for _, alphabet := range alphabets {
+		total += len(alphabet)
+	}
+	count := new(big.Int)
+	power := func(base int) *big.Int {
+		return new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(length)), nil)
+	}
+	if !every {
+		count = power(total)
+	} else {
+		for subset := 0; subset < 1<<len(alphabets); subset++ {
+			excluded, bits := 0, 0
+			for i, alphabet := range alphabets {
+				if subset&(1<<i) != 0 {
+					excluded += len(alphabet)
+					bits++
+				}
+			}
+			if bits%2 == 0 {
+				count.Add(count, power(total-excluded))
+			} else {
+				count.Sub(count, power(total-excluded))
+			}
+		}
+	}
+	return log2(count)
+}
+
+//大整数以2为底的对数,只取最高的53位计算
+func log2(n *big.Int) float64 {
+	if n.Sign() <= 0 {
+		return 0
+	}
+	shift := n.BitLen() - 53
+	if shift < 0 {
+		shift = 0
+	}
+	top, _ := new(big.Float).SetInt(new(big.Int).Rsh(n, uint(shift))).Float64()
+	return math.Log2(top) + float64(shift)
+}
@@ -0,0 +1,51 @@
+package zzdm
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+//加密套件对应的密钥长度
+func keyLength(cipher string) (int, error) {
+	switch cipher {
+	case "", CipherAES256CBC:
+		return 32, nil
+	case CipherAES192CBC:
+		return 24, nil
+	case CipherAES128CBC:
+		return 16, nil
+	}
+	return 0, ErrorCipher
+}
+
+//由密码派生密钥,旧版本文件头中没有cipher与kdf,按aes-256-cbc与none处理
+func deriveKey(password, cipher, kdf string, salt []byte, cost int) ([]byte, error) {
+	length, err := keyLength(cipher)
+	if err != nil {
+		return nil, err
+	}
+	switch kdf {
+	case "", KDFNone:
+		return stringBytes(password, length), nil
+	case KDFPBKDF2:
+		if cost <= 0 || len(salt) == 0 {
+			return nil, ErrorInvalidFile
+		}
+		return pbkdf2.Key([]byte(password), salt, cost, length, sha256.New), nil
+	}
+	return nil, ErrorKDF
+}
+
+//为文件头生成密钥派生所需的盐
+func newSalt(kdf string) ([]byte, error) {
+	if kdf == "" || kdf == KDFNone {
+		return nil, nil
+	}
+	salt := make([]byte, 16)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
@@ -0,0 +1,123 @@
+package zzdm
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	//文件格式版本,旧版本文件头中没有版本号
+	FormatVersion = 2
+	//文件头中随机向量的长度
+	nonceLength = 16
+)
+
+//单个文件使用的密钥
+type fileKeys struct {
+	//数据与元数据加密密钥
+	data []byte
+	//帧向量加密密钥
+	iv []byte
+	//加密帧向量时使用的向量
+	nonce []byte
+	//认证密钥,旧格式没有认证
+	mac []byte
+	//派生子密钥的主密钥,编辑后重新加密时沿用
+	master []byte
+}
+
+//由密码派生的主密钥与文件头中的随机向量生成各个子密钥
+//旧格式直接使用主密钥,并以defaultIv加密帧向量
+func newFileKeys(header *Header, master []byte) (*fileKeys, error) {
+	if header.Version < FormatVersion {
+		return &fileKeys{data: master, iv: master, nonce: defaultIv()}, nil
+	}
+	if len(header.Nonce) != nonceLength {
+		return nil, ErrorInvalidFile
+	}
+	keys := &fileKeys{nonce: header.Nonce, master: master}
+	reader := hkdf.New(sha256.New, master, header.Nonce, []byte("zzdm file keys"))
+	keys.data = make([]byte, len(master))
+	keys.iv = make([]byte, len(master))
+	keys.mac = make([]byte, 32)
+	for _, key := range [][]byte{keys.data, keys.iv, keys.mac} {
+		_, err := io.ReadFull(reader, key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+//由密码与文件头派生密钥并校验文件头
+func headerKeys(header *Header, password string) (*fileKeys, error) {
+	master, err := deriveKey(password, header.Cipher, header.Kdf, header.Salt, int(header.Cost))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := newFileKeys(header, master)
+	if err != nil {
+		return nil, err
+	}
+	if keys.mac != nil {
+		err = keys.checkHeader(header)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+//文件头的认证码,不包含mac字段本身
+func (k *fileKeys) headerMac(header *Header) ([]byte, error) {
+	unsigned := *header
+	unsigned.Mac = nil
+	message, err := unsigned.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	h := hmac.New(sha256.New, k.mac)
+	h.Write(message)
+	return h.Sum(nil), nil
+}
+
+//数据帧的认证码,包含帧序号以防止帧被调换或删除
+func (k *fileKeys) frameMac(index int64, iv, data []byte) []byte {
+	h := hmac.New(sha256.New, k.mac)
+	bytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(bytes, uint64(index))
+	h.Write(bytes)
+	binary.BigEndian.PutUint64(bytes, uint64(len(iv)))
+	h.Write(bytes)
+	h.Write(iv)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+//校验帧的认证码,包含组序号与组内序号
+func (k *fileKeys) parityMac(group, shard int64, parity []byte) []byte {
+	h := hmac.New(sha256.New, k.mac)
+	h.Write([]byte("parity"))
+	bytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(bytes, uint64(group))
+	h.Write(bytes)
+	binary.BigEndian.PutUint64(bytes, uint64(shard))
+	h.Write(bytes)
+	h.Write(parity)
+	return h.Sum(nil)
+}
+
+//新的随机向量
+func randomIv() ([]byte, error) {
+	iv := make([]byte, nonceLength)
+	_, err := rand.Read(iv)
+	if err != nil {
+		return nil, err
+	}
+	return iv, nil
+}
@@ -1,34 +1,217 @@
 package main
 
 import (
-	"github.com/mizk/zzdm"
-	"github.com/spf13/cobra"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
 	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mizk/zzdm"
+	"github.com/spf13/cobra"
 )
 
 var (
-	secret   = false
-	force    = false
-	version  = false
-	advice   = false
-	password = ""
-	input    = ""
-	output   = ""
+	secret     = false
+	force      = false
+	version    = false
+	advice     = false
+	noPreserve = false
+	recursive  = false
+	password   = ""
+	input      = ""
+	output     = ""
+	padding    = zzdm.PaddingNone
+	bucket     = int64(1 << 20)
+	parity     = 0
+	shards     = zzdm.DefaultParityShards
+	zeroFill   = false
+	resume     = false
+	armor      = false
+	text       = ""
+	textMode   = false
+	format     = zzdm.FormatZZDM
+	recipients = []string{}
+	identities = []string{}
+	iter       = zzdm.OpenSSLCost
+	digest     = zzdm.DigestSHA256
+	signKey    = ""
+	signers    = []string{}
+	comment    = ""
+	shares     = 0
+	threshold  = 0
+	shareFiles = []string{}
+	breaches   = []string{}
+	breachWarn = false
+	fpRate     = zzdm.DefaultFalsePositive
+	policyFile = ""
+	minLength  = 0
+	minEntropy = 0.0
+	classes    = []string{}
+	banned     = []string{}
+	maxAge     = ""
+	allowWeak  = false
+	words      = zzdm.DefaultPassphraseWords
+	separator  = "-"
+	length     = zzdm.DefaultPasswordLength
+	cipher     = zzdm.CipherAES256CBC
+	frameSize  = zzdm.BUFFER
+	kdfCost    = zzdm.DefaultKDFCost
+	config     = zzdm.DefaultConfig()
+	outFormat  = formatText
+	messages   = io.Writer(os.Stdout)
+	current    = "zzdm"
+	started    = time.Now()
+	reported   = 0
+	conflict   = "error"
+	outputFile = ""
+	removeSrc  = false
+	passes     = zzdm.DefaultShredPasses
+	filesFrom  = ""
+	nullList   = false
+	jobs       = runtime.NumCPU()
+	results    = map[string]*zzdm.FileResult{}
+	resultLock sync.Mutex
+)
+
+//--output-format的取值
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+//结果中的错误代码与对应的退出码,脚本可以依赖这些值
+const (
+	codeOK        = "ok"
+	codeSkipped   = "skipped"
+	codeFailed    = "failed"
+	codeUsage     = "usage"
+	codeAuth      = "authentication_failed"
+	codeExists    = "output_exists"
+	codeInvalid   = "invalid_file"
+	codeCancelled = "cancelled"
+	codePassword  = "password_required"
+	codeRejected  = "password_rejected"
+	codeKey       = "invalid_key"
+	codeInput     = "input_missing"
+	codeConfig    = "invalid_config"
 )
 
+var exitCodes = map[string]int{
+	codeOK:        0,
+	codeSkipped:   0,
+	codeFailed:    1,
+	codeUsage:     2,
+	codeAuth:      3,
+	codeExists:    4,
+	codeInvalid:   5,
+	codeCancelled: 130,
+	codePassword:  254,
+	codeRejected:  254,
+	codeKey:       254,
+	codeInput:     255,
+	codeConfig:    255,
+}
+
+//--output-format json时每个文件或命令输出一行的结果
+type result struct {
+	Command string `json:"command"`
+	Input   string `json:"input,omitempty"`
+	Output  string `json:"output,omitempty"`
+	Bytes   int64  `json:"bytes"`
+	Frames  int64  `json:"frames"`
+	//耗时,单位为秒
+	Duration float64     `json:"duration"`
+	Format   string      `json:"format,omitempty"`
+	Cipher   string      `json:"cipher,omitempty"`
+	Code     string      `json:"code"`
+	Message  string      `json:"message,omitempty"`
+	Details  interface{} `json:"details,omitempty"`
+}
+
+//命令行参数对应的配置项
+var configFlags = []struct {
+	flag string
+	key  string
+}{
+	{"output", "output"},
+	{"force", "force"},
+	{"on-conflict", "on_conflict"},
+	{"frame-size", "frame_size"},
+	{"cipher", "cipher"},
+	{"kdf-cost", "kdf_cost"},
+	{"shred-passes", "shred_passes"},
+	{"breach-list", "breach_lists"},
+	{"recipient", "keys.recipients"},
+	{"identity", "keys.identities"},
+	{"sign-key", "keys.signing_key"},
+	{"key", "keys.signing_key"},
+	{"signer", "keys.signers"},
+}
+
+//口令策略参数对应的配置项,--policy与--ban单独处理
+var policyConfigFlags = []struct {
+	flag string
+	key  string
+}{
+	{"min-length", "policy.min_length"},
+	{"min-entropy", "policy.min_entropy"},
+	{"require", "policy.classes"},
+	{"max-age", "policy.max_age"},
+}
+
+//使用分层配置的命令
+const configAnnotation = "config"
+
 const (
-	ROOT       = iota
+	ROOT = iota
 	ENCRYPTION
 	DECRYPTION
+	VERIFICATION
+	SALVAGE
 )
 
 func main() {
+	//收到SIGINT时取消正在进行的加解密并清理未完成的输出
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
 	command := &cobra.Command{Use: "zzdm",
 		Short: "zzdm is a file encryption/decryption tool with aes crypt",
+		Long: "zzdm [--output-format text|json] $command, with --output-format json every file and command prints one JSON object per line\n" +
+			"exit codes: 0 ok, 1 failed, 2 usage, 3 authentication failed, 4 output exists, 5 invalid file, 130 cancelled, " +
+			"254 password required or rejected, 255 input missing or invalid configuration, a batch exits with the code of its first failed file",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			current = strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+			if outFormat != formatText && outFormat != formatJSON {
+				fail(codeUsage, fmt.Sprintf("unknown output format %q, expected text or json", outFormat))
+			}
+			if jsonMode() {
+				//提示信息输出到标准错误,标准输出只有JSON
+				messages = os.Stderr
+			}
+			if _, ok := cmd.Annotations[configAnnotation]; ok {
+				loadConfig(cmd)
+			}
+		},
 		Run: func(cmd *cobra.Command, args []string) {
 			if version {
+				if jsonMode() {
+					emit(&result{Details: map[string]string{"version": zzdm.Version, "sku": zzdm.SKU}})
+					return
+				}
 				fmt.Printf("zzdm-%s-%s\n", zzdm.Version, zzdm.SKU)
 			} else {
 				cmd.Println(cmd.UsageString())
@@ -38,28 +221,45 @@ func main() {
 	encrypt := &cobra.Command{
 		Use:   "encrypt",
 		Short: "Encrypt a file",
-		Long:  "zzdm encrypt [-s | --secret] [-a | --advice] [-f | --force] (-i | --input $input) [-o | --output $output] (-p | --password $password)",
+		Long:  "zzdm encrypt [-j | --jobs $count] [--files-from $list|- [--null]] [-s | --secret] [-a | --advice] [-f | --force] [--on-conflict error|overwrite|skip|rename|newer] [-r | --recursive] [--resume] [--no-preserve] [--armor] [--cipher $suite] [--kdf-cost $count] [--frame-size $bytes] [--format zzdm|age|openssl [--recipient $key]... [--iter $count] [--md sha256|sha1|md5]] [--sign-key $key] [--shares $count --threshold $count] [--breach-list $file]... [--breach-warn] [--policy $file] [--min-length $count] [--min-entropy $bits] [--require upper|lower|digit|symbol]... [--ban $word]... [--allow-weak] [--padding none|pow2|padme|bucket [--bucket $bytes]] [--parity $frames [--parity-shards $count]] [--remove-source|--shred [--shred-passes $count]] (-i | --input $input | --text $text | $file|$glob...) [-o | --output $output | --output-file $file] (-p | --password $password)",
 		Run: func(cmd *cobra.Command, args []string) {
-			if !zzdm.Exist(input) {
-				fmt.Println("input file is missing")
-				os.Exit(-1)
+			if cmd.Flags().Changed("text") {
+				if len(password) == 0 && len(recipients) == 0 {
+					fail(codePassword, "password is required")
+				}
+				enforcePassword(cmd, password)
+				//短文本直接以文本格式输出到标准输出
+				options := append(encryptOptions(), zzdm.WithArmor(true), zzdm.WithProgress(nil))
+				writer, textResult := textOutput()
+				err := zzdm.NewEncryptor(options...).EncryptStream(ctx, strings.NewReader(text), writer, zzdm.TextName, password, int64(len(text)))
+				finish(err, textResult())
 				return
 			}
-			if !zzdm.IsDir(output) {
-				output = ""
+			inputs := batchInputs(cmd, args)
+			if inputs == nil && !zzdm.Exist(input) {
+				fail(codeInput, "input file is missing")
 			}
-			if len(password) == 0 {
-				fmt.Println("password is required")
-				os.Exit(-2)
-				return
+			if len(password) == 0 && len(recipients) == 0 && shares == 0 {
+				fail(codePassword, "password is required")
 			}
 			if advice {
 				checkPassword(password)
 			}
-			err := zzdm.Encrypt(input, output, password, secret, force)
-			if err != nil {
-				fmt.Printf("%v\n", err)
+			enforcePassword(cmd, password)
+			if inputs != nil {
+				options := append(encryptOptions(), zzdm.WithProgress(nil))
+				errs := zzdm.NewEncryptor(options...).EncryptFiles(ctx, inputs, output, password, jobs)
+				batchSummary(inputs, errs)
+				return
 			}
+			var err error
+			if recursive {
+				err = zzdm.NewEncryptor(encryptOptions()...).EncryptDir(ctx, input, output, password)
+			} else {
+				checkOutput(cmd)
+				err = zzdm.NewEncryptor(encryptOptions()...).Encrypt(ctx, input, output, password)
+			}
+			finish(err, nil)
 		},
 	}
 	parseFlag(encrypt, ENCRYPTION)
@@ -68,64 +268,1052 @@ func main() {
 	decrypt := &cobra.Command{
 		Use:   "decrypt",
 		Short: "Decrypt a file",
-		Long:  "zzdm decrypt [--force] (-i|--input $input) [-o|--output $output] (-p|--password $password)",
+		Long:  "zzdm decrypt [-j|--jobs $count] [--files-from $list|- [--null]] [--force] [--on-conflict error|overwrite|skip|rename|newer] [-r|--recursive] [--resume] [--no-preserve] [--text] [--format zzdm|age|openssl] [--identity $file]... [--iter $count] [--md sha256|sha1|md5] [--cipher $suite] [--signer $keys]... [--share $file]... [--policy $file] [--max-age $days] (-i|--input $input|$file|$glob...) [-o|--output $output|--output-file $file] (-p|--password $password)",
 		Run: func(cmd *cobra.Command, args []string) {
-			if !zzdm.Exist(input) {
-				fmt.Println("input file is missing")
-				os.Exit(-1)
+			if textMode {
+				if len(password) == 0 && len(identities) == 0 && len(shareFiles) == 0 {
+					fail(codePassword, "password is required")
+				}
+				//明文输出到标准输出,没有输入文件时从标准输入读取
+				reader := os.Stdin
+				if len(input) > 0 {
+					file, err := os.Open(input)
+					if err != nil {
+						finish(err, nil)
+					}
+					defer file.Close()
+					reader = file
+				}
+				options := append(decryptOptions(), zzdm.WithProgress(nil))
+				writer, textResult := textOutput()
+				err := zzdm.NewDecryptor(options...).DecryptStream(ctx, reader, writer, password)
+				finish(err, textResult())
 				return
 			}
-			if !zzdm.IsDir(output) {
-				output = ""
+			inputs := batchInputs(cmd, args)
+			if inputs == nil && !zzdm.Exist(input) {
+				fail(codeInput, "input file is missing")
 			}
-			if len(password) == 0 {
-				fmt.Print("password is required")
-				os.Exit(-2)
+			if len(password) == 0 && len(identities) == 0 && len(shareFiles) == 0 {
+				fail(codePassword, "password is required")
+			}
+			if inputs != nil {
+				options := append(decryptOptions(), zzdm.WithProgress(nil))
+				errs := zzdm.NewDecryptor(options...).DecryptFiles(ctx, inputs, output, password, jobs)
+				for i, path := range inputs {
+					if errs[i] == nil {
+						warnPasswordAge(path)
+					}
+				}
+				batchSummary(inputs, errs)
 				return
 			}
-			err := zzdm.Decrypt(input, output, password, force)
-			if err != nil {
-				fmt.Printf("%v\n", err)
+			var err error
+			if recursive {
+				err = zzdm.NewDecryptor(decryptOptions()...).DecryptDir(ctx, input, output, password)
+			} else {
+				checkOutput(cmd)
+				err = zzdm.NewDecryptor(decryptOptions()...).Decrypt(ctx, input, output, password)
+			}
+			if err == nil {
+				warnPasswordAge(input)
 			}
+			finish(err, nil)
 		},
 	}
 	parseFlag(decrypt, DECRYPTION)
 	command.AddCommand(decrypt)
+
+	verify := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify an encrypted file without writing the plaintext",
+		Long:  "zzdm verify [--signer $keys]... [--policy $file] [--max-age $days] (-i|--input $input) (-p|--password $password)",
+		Run: func(cmd *cobra.Command, args []string) {
+			if !zzdm.Exist(input) {
+				fail(codeInput, "input file is missing")
+			}
+			if len(password) == 0 {
+				fail(codePassword, "password is required")
+			}
+			err := zzdm.NewDecryptor(decryptOptions()...).Verify(ctx, input, password)
+			if err == nil {
+				if !jsonMode() {
+					fmt.Println("OK")
+				}
+				warnPasswordAge(input)
+			}
+			finish(err, nil)
+		},
+	}
+	parseFlag(verify, VERIFICATION)
+	verify.PersistentFlags().StringArrayVar(&signers, "signer", nil, "file of trusted Ed25519 public keys, the file must be signed by one of them")
+	policyFlags(verify, false)
+	command.AddCommand(verify)
+
+	cat := &cobra.Command{
+		Use:   "cat",
+		Short: "Decrypt files to stdout without writing the plaintext to disk",
+		Long:  "zzdm cat [--format zzdm|age|openssl] [--identity $file]... [--iter $count] [--md sha256|sha1|md5] [--cipher $suite] [--signer $keys]... [--share $file]... (-i|--input $input|$file...) (-p|--password $password), the signature is checked after the plaintext has been written",
+		Run: func(cmd *cobra.Command, args []string) {
+			inputs := args
+			if len(input) > 0 {
+				inputs = append([]string{input}, inputs...)
+			}
+			if len(inputs) == 0 {
+				fail(codeInput, "input file is missing")
+			}
+			if len(password) == 0 && len(identities) == 0 && len(shareFiles) == 0 {
+				fail(codePassword, "password is required")
+			}
+			decryptor := zzdm.NewDecryptor(append(decryptOptions(), zzdm.WithProgress(nil))...)
+			writer, textResult := textOutput()
+			for _, path := range inputs {
+				file, err := os.Open(path)
+				if err != nil {
+					finish(err, &result{Input: path})
+				}
+				err = decryptor.DecryptStream(ctx, file, writer, password)
+				file.Close()
+				if err != nil {
+					finish(err, &result{Input: path})
+				}
+			}
+			finish(nil, textResult())
+		},
+	}
+	parseFlag(cat, VERIFICATION)
+	cat.PersistentFlags().StringVar(&format, "format", zzdm.FormatZZDM, "input format: zzdm, age or openssl, detected automatically")
+	cat.PersistentFlags().StringArrayVar(&identities, "identity", nil, "age identity file, can be repeated")
+	cat.PersistentFlags().IntVar(&iter, "iter", zzdm.OpenSSLCost, "PBKDF2 iterations of an openssl file, 0 for EVP_BytesToKey")
+	cat.PersistentFlags().StringVar(&digest, "md", zzdm.DigestSHA256, "digest of an openssl file: sha256, sha1 or md5")
+	cat.PersistentFlags().StringVar(&cipher, "cipher", zzdm.CipherAES256CBC, "cipher of an openssl file: aes-256-cbc, aes-192-cbc or aes-128-cbc")
+	cat.PersistentFlags().StringArrayVar(&signers, "signer", nil, "file of trusted Ed25519 public keys, the file must be signed by one of them")
+	cat.PersistentFlags().StringArrayVar(&shareFiles, "share", nil, "key share file written by encrypt --shares, repeat until the threshold is reached")
+	command.AddCommand(cat)
+
+	edit := &cobra.Command{
+		Use:   "edit",
+		Short: "Edit an encrypted file with $EDITOR and encrypt it again with the same settings",
+		Long:  "zzdm edit [--signer $keys]... [--share $file]... [--sign-key $key] [--padding none|pow2|padme|bucket [--bucket $bytes]] (-i|--input $input|$file) (-p|--password $password), the plaintext is kept in a private temporary directory, in memory when possible, and shredded afterwards; the cipher, frame size, parity, naming, padding and signer of the file are kept",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) > 0 {
+				input = args[0]
+			}
+			if !zzdm.Exist(input) {
+				fail(codeInput, "input file is missing")
+			}
+			if len(password) == 0 && len(shareFiles) == 0 {
+				fail(codePassword, "password is required")
+			}
+			if _, memory := zzdm.PrivateTempDir(); !memory {
+				fmt.Fprintln(os.Stderr, "warning: no memory filesystem was found, the plaintext is written to the temporary directory on disk until the editor exits")
+			}
+			options := append(decryptOptions(), zzdm.WithPadding(padding, bucket), zzdm.WithProgress(nil))
+			options = append(options, signingOptions()...)
+			changed, err := zzdm.NewEncryptor(options...).Edit(ctx, input, password, runEditor)
+			if err == nil && !changed && !jsonMode() {
+				fmt.Println("no changes")
+			}
+			finish(err, &result{Output: input, Details: map[string]bool{"changed": changed}})
+		},
+	}
+	parseFlag(edit, VERIFICATION)
+	edit.PersistentFlags().StringArrayVar(&signers, "signer", nil, "file of trusted Ed25519 public keys, the file must be signed by one of them")
+	edit.PersistentFlags().StringArrayVar(&shareFiles, "share", nil, "key share file written by encrypt --shares, repeat until the threshold is reached")
+	edit.PersistentFlags().StringVar(&signKey, "sign-key", "", "Ed25519 private key of the signer, required when the file is signed")
+	edit.PersistentFlags().StringVar(&padding, "padding", zzdm.PaddingNone, "padding for files that do not record one: none, pow2, padme or bucket, otherwise the recorded padding is kept")
+	edit.PersistentFlags().Int64Var(&bucket, "bucket", 1<<20, "bucket size in bytes for --padding bucket")
+	command.AddCommand(edit)
+
+	sign := &cobra.Command{
+		Use:   "sign",
+		Short: "Verify an encrypted file and sign it with an Ed25519 key, replacing any previous signature",
+		Long:  "zzdm sign (--key $key) (-i|--input $input) (-p|--password $password)",
+		Run: func(cmd *cobra.Command, args []string) {
+			if !zzdm.Exist(input) {
+				fail(codeInput, "input file is missing")
+			}
+			if len(password) == 0 || len(signKey) == 0 {
+				fail(codePassword, "password and signing key are required")
+			}
+			err := zzdm.NewEncryptor(encryptOptions()...).Sign(ctx, input, password)
+			finish(err, &result{Output: input})
+		},
+	}
+	parseFlag(sign, VERIFICATION)
+	sign.PersistentFlags().StringVar(&signKey, "key", "", "Ed25519 private key generated by zzdm keygen")
+	command.AddCommand(sign)
+
+	keygen := &cobra.Command{
+		Use:   "keygen",
+		Short: "Generate an Ed25519 signing key and its public key file",
+		Long:  "zzdm keygen [-f|--force] [--comment $text] $key",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			overwrite := zzdm.OverwriteNever
+			if force {
+				overwrite = zzdm.OverwriteForce
+			}
+			public, err := zzdm.GenerateSigningKey(args[0], comment, overwrite)
+			if err != nil {
+				finish(err, &result{Output: args[0]})
+			}
+			signer := &zzdm.Signer{Key: public}
+			if !jsonMode() {
+				fmt.Printf("%s%s %s\n", args[0], zzdm.PublicKeyExtension, signer.Fingerprint())
+				return
+			}
+			finish(nil, &result{Output: args[0], Details: map[string]string{"public_key": args[0] + zzdm.PublicKeyExtension, "fingerprint": signer.Fingerprint()}})
+		},
+	}
+	keygen.PersistentFlags().BoolVarP(&force, "force", "f", false, "overwrite an existing key")
+	keygen.PersistentFlags().StringVar(&comment, "comment", "", "name written after the public key and reported when verifying")
+	command.AddCommand(keygen)
+
+	passwordCmd := &cobra.Command{
+		Use:   "password",
+		Short: "Check password strength and breached password lists",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Println(cmd.UsageString())
+		},
+	}
+	check := &cobra.Command{
+		Use:   "check",
+		Short: "Estimate the strength of a password and look it up in breached password lists",
+		Long:  "zzdm password check [--breach-list $file]... [--policy $file] [--min-length $count] [--min-entropy $bits] [--require upper|lower|digit|symbol]... [--ban $word]... [-p|--password $password], the password is read from stdin when omitted",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(password) == 0 {
+				line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+				if err != nil && err != io.EOF {
+					fail(codePassword, err.Error())
+				}
+				password = strings.TrimRight(line, "\r\n")
+			}
+			strength := checkPassword(password)
+			breached := checkBreached(password)
+			violations := config.Policy.Violations(password, passwordInputs()...)
+			for _, violation := range violations {
+				fmt.Fprintf(messages, "[PA]:Policy: %s\n", violation)
+			}
+			var err error
+			if breached {
+				err = zzdm.ErrorBreached
+			} else if len(violations) > 0 {
+				err = fmt.Errorf("%w: %s", zzdm.ErrorPolicy, strings.Join(violations, ", "))
+			}
+			if !jsonMode() && err != nil {
+				os.Exit(exitCodes[codeRejected])
+			}
+			finish(err, &result{Details: map[string]interface{}{
+				"score":       strength.Score,
+				"guesses":     strength.Guesses,
+				"crack_time":  strength.CrackTime,
+				"problems":    strength.Problems,
+				"suggestions": strength.Suggestions,
+				"breached":    breached,
+				"violations":  violations,
+			}})
+		},
+	}
+	check.Annotations = map[string]string{configAnnotation: ""}
+	check.PersistentFlags().StringVarP(&password, "password", "p", "", "password to check")
+	policyFlags(check, true)
+	check.PersistentFlags().StringArrayVar(&breaches, "breach-list", nil, "sorted SHA-1 list in Have I Been Pwned format or a filter from zzdm password bloom, can be repeated")
+	passwordCmd.AddCommand(check)
+	bloom := &cobra.Command{
+		Use:   "bloom",
+		Short: "Build a compact bloom filter from a password list or a Have I Been Pwned SHA-1 list",
+		Long:  "zzdm password bloom [-f|--force] [--false-positive $rate] (-i|--input $list) (-o|--output $filter)",
+		Run: func(cmd *cobra.Command, args []string) {
+			if !zzdm.Exist(input) {
+				fail(codeInput, "input file is missing")
+			}
+			if len(output) == 0 {
+				output = input + zzdm.BloomExtension
+			}
+			overwrite := zzdm.OverwriteNever
+			if force {
+				overwrite = zzdm.OverwriteForce
+			}
+			entries, err := zzdm.BuildBreachFilter(input, output, fpRate, overwrite)
+			if err == nil && !jsonMode() {
+				fmt.Printf("%s %d entries\n", output, entries)
+			}
+			finish(err, &result{Output: output, Details: map[string]int64{"entries": entries}})
+		},
+	}
+	bloom.PersistentFlags().StringVarP(&input, "input", "i", "", "password list, one password or SHA-1 hash per line")
+	bloom.PersistentFlags().StringVarP(&output, "output", "o", "", "filter file, defaults to the input with "+zzdm.BloomExtension)
+	bloom.PersistentFlags().BoolVarP(&force, "force", "f", false, "overwrite an existing filter")
+	bloom.PersistentFlags().Float64Var(&fpRate, "false-positive", zzdm.DefaultFalsePositive, "false positive rate of the filter")
+	passwordCmd.AddCommand(bloom)
+	command.AddCommand(passwordCmd)
+
+	genpass := &cobra.Command{
+		Use:   "genpass",
+		Short: "Generate a random passphrase or password, and optionally encrypt a file with it",
+		Long:  "zzdm genpass [--words $count] [--separator $text] [--length $count] [--require upper|lower|digit|symbol]... [-i|--input $input [-o|--output $output] [-f|--force]]",
+		Run: func(cmd *cobra.Command, args []string) {
+			var generated *zzdm.GeneratedPassword
+			var err error
+			//指定了长度或字符类别时生成字符口令,否则生成口令短语
+			if cmd.Flags().Changed("length") || cmd.Flags().Changed("require") {
+				generated, err = zzdm.GeneratePassword(length, classes...)
+			} else {
+				generated, err = zzdm.GeneratePassphrase(words, separator)
+			}
+			if err != nil {
+				fail(codeFailed, err.Error())
+			}
+			if jsonMode() {
+				emit(&result{Duration: time.Since(started).Seconds(), Details: generated})
+				reported++
+			} else {
+				//口令单独输出到标准输出,便于管道使用
+				fmt.Println(generated.Password)
+				fmt.Fprintf(os.Stderr, "[PA]:Entropy %.1f bits\n", generated.Entropy)
+			}
+			if len(input) == 0 {
+				return
+			}
+			if !zzdm.Exist(input) {
+				fail(codeInput, "input file is missing")
+			}
+			if recursive {
+				err = zzdm.NewEncryptor(encryptOptions()...).EncryptDir(ctx, input, output, generated.Password)
+			} else {
+				checkOutput(cmd)
+				err = zzdm.NewEncryptor(encryptOptions()...).Encrypt(ctx, input, output, generated.Password)
+			}
+			finish(err, nil)
+		},
+	}
+	genpass.Annotations = map[string]string{configAnnotation: ""}
+	genpass.PersistentFlags().IntVar(&words, "words", zzdm.DefaultPassphraseWords, "number of diceware words in the passphrase")
+	genpass.PersistentFlags().StringVar(&separator, "separator", "-", "text between the words of the passphrase")
+	genpass.PersistentFlags().IntVar(&length, "length", zzdm.DefaultPasswordLength, "generate a password of this many characters instead of a passphrase")
+	genpass.PersistentFlags().StringArrayVar(&classes, "require", nil, "character class of the password: upper, lower, digit or symbol, can be repeated, all by default")
+	genpass.PersistentFlags().StringVarP(&input, "input", "i", "", "encrypt this file with the generated password")
+	genpass.PersistentFlags().StringVarP(&output, "output", "o", "", "output directory for --input")
+	genpass.PersistentFlags().BoolVarP(&force, "force", "f", false, "force to overwrite an existing file within the output directory")
+	genpass.PersistentFlags().BoolVarP(&recursive, "recursive", "r", false, "encrypt a directory recursively into the output directory")
+	command.AddCommand(genpass)
+
+	migrate := &cobra.Command{
+		Use:   "migrate",
+		Short: "Convert a file written by an older version to the current format in place",
+		Long:  "zzdm migrate (-i|--input $input) (-p|--password $password)",
+		Run: func(cmd *cobra.Command, args []string) {
+			if !zzdm.Exist(input) {
+				fail(codeInput, "input file is missing")
+			}
+			if len(password) == 0 {
+				fail(codePassword, "password is required")
+			}
+			err := zzdm.NewEncryptor(encryptOptions()...).Migrate(ctx, input, password)
+			finish(err, &result{Output: input})
+		},
+	}
+	parseFlag(migrate, VERIFICATION)
+	command.AddCommand(migrate)
+
+	repair := &cobra.Command{
+		Use:   "repair",
+		Short: "Rebuild damaged or missing frames of a file encrypted with --parity",
+		Long:  "zzdm repair (-i|--input $input) (-p|--password $password)",
+		Run: func(cmd *cobra.Command, args []string) {
+			if !zzdm.Exist(input) {
+				fail(codeInput, "input file is missing")
+			}
+			if len(password) == 0 {
+				fail(codePassword, "password is required")
+			}
+			count, err := zzdm.NewDecryptor(decryptOptions()...).Repair(ctx, input, password)
+			if err == nil && !jsonMode() {
+				fmt.Printf("%d frames rebuilt\n", count)
+			}
+			finish(err, &result{Output: input, Frames: int64(count)})
+		},
+	}
+	parseFlag(repair, VERIFICATION)
+	command.AddCommand(repair)
+
+	appendCmd := &cobra.Command{
+		Use:   "append",
+		Short: "Append a file to an existing encrypted file without decrypting it",
+		Long:  "zzdm append [--padding none|pow2|padme|bucket [--bucket $bytes]] [--sign-key $key] (-i|--input $input) (-p|--password $password) $archive",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if !zzdm.Exist(input) || !zzdm.Exist(args[0]) {
+				fail(codeInput, "input file is missing")
+			}
+			if len(password) == 0 {
+				fail(codePassword, "password is required")
+			}
+			err := zzdm.NewEncryptor(encryptOptions()...).Append(ctx, input, args[0], password)
+			finish(err, &result{Output: args[0], Bytes: zzdm.FileLength(input)})
+		},
+	}
+	parseFlag(appendCmd, VERIFICATION)
+	appendCmd.PersistentFlags().StringVar(&padding, "padding", zzdm.PaddingNone, "padding for archives that do not record one: none, pow2, padme or bucket, otherwise the recorded padding is kept")
+	appendCmd.PersistentFlags().Int64Var(&bucket, "bucket", 1<<20, "bucket size in bytes for --padding bucket")
+	appendCmd.PersistentFlags().StringVar(&signKey, "sign-key", "", "sign the result with this Ed25519 private key, the previous signature is removed")
+	command.AddCommand(appendCmd)
+
+	salvage := &cobra.Command{
+		Use:   "salvage",
+		Short: "Decrypt every intact frame of a damaged file and report the lost byte ranges",
+		Long:  "zzdm salvage [-f|--force] [--on-conflict error|overwrite|skip|rename|newer] [--no-preserve] [--zero-fill] (-i|--input $input) [-o|--output $output|--output-file $file] (-p|--password $password)",
+		Run: func(cmd *cobra.Command, args []string) {
+			if !zzdm.Exist(input) {
+				fail(codeInput, "input file is missing")
+			}
+			if len(password) == 0 {
+				fail(codePassword, "password is required")
+			}
+			checkOutput(cmd)
+			report, err := zzdm.NewDecryptor(decryptOptions()...).Salvage(ctx, input, output, password, zeroFill)
+			if err != nil {
+				finish(err, nil)
+			}
+			if len(report.Output) == 0 {
+				if jsonMode() {
+					emit(&result{Input: input, Code: codeSkipped, Duration: time.Since(started).Seconds()})
+				} else {
+					fmt.Fprintf(messages, "%s skipped, the output file already exists\n", input)
+				}
+				return
+			}
+			if jsonMode() {
+				finish(nil, &result{Output: report.Output, Frames: report.Recovered, Details: report})
+				return
+			}
+			for _, lost := range report.Lost {
+				fmt.Printf("lost{offset=%d,length=%d}\n", lost.Offset, lost.Length)
+			}
+			fmt.Printf("recovered %d of %d frames, %d bytes skipped\n", report.Recovered, report.Frames, report.Skipped)
+		},
+	}
+	parseFlag(salvage, SALVAGE)
+	command.AddCommand(salvage)
+
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the layered configuration",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Println(cmd.UsageString())
+		},
+	}
+	show := &cobra.Command{
+		Use:         "show",
+		Short:       "Print the effective settings and where each value came from",
+		Long:        "zzdm config show, settings are merged from /etc/zzdm/config.toml, ~/.config/zzdm/config.toml, the nearest .zzdm.toml, ZZDM_* environment variables and flags, later ones win, a .zzdm.toml may only set naming and frame_size unless project_config = true is set outside of it",
+		Annotations: map[string]string{configAnnotation: ""},
+		Run: func(cmd *cobra.Command, args []string) {
+			if jsonMode() {
+				finish(nil, &result{Details: config.Settings()})
+				return
+			}
+			for _, setting := range config.Settings() {
+				fmt.Printf("%s = %s # %s\n", setting.Key, setting.Value, setting.Source)
+			}
+		},
+	}
+	configCmd.AddCommand(show)
+	command.AddCommand(configCmd)
+	command.SilenceErrors = true
 	err := command.Execute()
 	if err != nil {
+		fail(codeUsage, err.Error())
+	}
+}
+
+//加密命令的配置
+func encryptOptions() []zzdm.Option {
+	naming := zzdm.NamingOriginal
+	if secret {
+		naming = zzdm.NamingSecret
+	}
+	options := []zzdm.Option{
+		zzdm.WithNaming(naming),
+		zzdm.WithOverwrite(config.Overwrite()),
+		zzdm.WithOutputFile(outputFile),
+		zzdm.WithResult(fileResult),
+		zzdm.WithCipher(config.Cipher),
+		zzdm.WithKDF(config.KDF, config.KDFCost),
+		zzdm.WithFrameSize(config.FrameSize),
+		zzdm.WithResume(resume),
+		zzdm.WithArmor(armor),
+		zzdm.WithFormat(format),
+		zzdm.WithRecipients(recipients...),
+		zzdm.WithPadding(padding, bucket),
+		zzdm.WithParity(parity, shards),
+		zzdm.WithShares(shares, threshold),
+		zzdm.WithPreserve(!noPreserve),
+		zzdm.WithProgress(func(progress zzdm.Progress) {
+			fmt.Printf("frame{index=%d,max=%d,bytes=%d}\n", progress.Index, progress.Frames, progress.Bytes)
+		}),
+	}
+	if jsonMode() {
+		options = append(options, zzdm.WithProgress(nil))
+	}
+	if format == zzdm.FormatOpenSSL {
+		options = append(options, opensslOptions()...)
+	}
+	if removeSrc {
+		if format == zzdm.FormatAge && len(recipients) > 0 {
+			fail(codeUsage, "--remove-source cannot check an output encrypted to --recipient, decrypt it with the identity before removing the source")
+		}
+		fmt.Fprintln(os.Stderr, "warning: --remove-source overwrites the source in place, copy-on-write, journaling and snapshot filesystems or SSDs may still keep the plaintext")
+		options = append(options, zzdm.WithRemoveSource(config.ShredPasses))
+	}
+	return append(options, signingOptions()...)
+}
+
+//--sign-key指定的签名私钥
+func signingOptions() []zzdm.Option {
+	if len(signKey) == 0 {
+		return nil
+	}
+	key, err := zzdm.LoadSigningKey(signKey)
+	if err != nil {
+		fail(codeKey, err.Error())
+	}
+	return []zzdm.Option{zzdm.WithSigningKey(key)}
+}
+
+//以$VISUAL或$EDITOR编辑文件,编辑器命令可以带参数
+func runEditor(ctx context.Context, path string) error {
+	editor := os.Getenv("VISUAL")
+	if len(editor) == 0 {
+		editor = os.Getenv("EDITOR")
+	}
+	if len(editor) == 0 {
+		editor = "vi"
+		if runtime.GOOS == "windows" {
+			editor = "notepad"
+		}
+	}
+	fields := strings.Fields(editor)
+	command := exec.CommandContext(ctx, fields[0], append(fields[1:], path)...)
+	command.Stdin = os.Stdin
+	command.Stdout = os.Stdout
+	command.Stderr = os.Stderr
+	return command.Run()
+}
+
+//解密命令的配置
+func decryptOptions() []zzdm.Option {
+	//openssl格式的文件头中没有密钥派生参数,识别为openssl格式时使用命令行的参数
+	options := []zzdm.Option{
+		zzdm.WithOverwrite(config.Overwrite()),
+		zzdm.WithOutputFile(outputFile),
+		zzdm.WithResult(fileResult),
+		zzdm.WithResume(resume),
+		zzdm.WithFormat(format),
+		zzdm.WithIdentities(identities...),
+		zzdm.WithShareFiles(shareFiles...),
+		zzdm.WithPreserve(!noPreserve),
+		zzdm.WithProgress(func(progress zzdm.Progress) {
+			fmt.Printf("frame{index=%d,max=%d}\n", progress.Index, progress.Frames)
+		}),
+	}
+	if jsonMode() {
+		options = append(options, zzdm.WithProgress(nil))
+	}
+	//openssl格式的文件头中也没有加密套件
+	options = append(options, zzdm.WithCipher(cipher))
+	options = append(options, opensslOptions()...)
+	trusted := make([]zzdm.Signer, 0)
+	for _, path := range signers {
+		keys, err := zzdm.LoadTrustedKeys(path)
+		if err != nil {
+			fail(codeKey, err.Error())
+		}
+		trusted = append(trusted, keys...)
+	}
+	//签名者输出到标准错误,不混入--text输出的明文
+	return append(options, zzdm.WithTrustedKeys(trusted...), zzdm.WithSigned(func(signer *zzdm.Signer) {
+		fmt.Fprintf(os.Stderr, "signed by %s\n", signer)
+	}))
+}
+
+//openssl格式的密钥派生,迭代次数为0时使用EVP_BytesToKey
+func opensslOptions() []zzdm.Option {
+	kdf := zzdm.KDFPBKDF2
+	if iter == 0 {
+		kdf = zzdm.KDFEVP
+	}
+	return []zzdm.Option{
+		zzdm.WithKDF(kdf, iter),
+		zzdm.WithDigest(digest),
+	}
+}
+
+func jsonMode() bool {
+	return outFormat == formatJSON
+}
+
+//输出一行JSON结果
+func emit(r *result) {
+	if len(r.Command) == 0 {
+		r.Command = current
+	}
+	if len(r.Code) == 0 {
+		r.Code = codeOK
+	}
+	line, _ := json.Marshal(r)
+	fmt.Println(string(line))
+}
+
+//错误对应的错误代码
+func errorCode(err error) string {
+	switch {
+	case err == nil:
+		return codeOK
+	case errors.Is(err, context.Canceled):
+		return codeCancelled
+	case errors.Is(err, zzdm.ErrorFileDuplicated), errors.Is(err, zzdm.ErrorOutputDuplicated):
+		return codeExists
+	case errors.Is(err, zzdm.ErrorAuthentication), errors.Is(err, zzdm.ErrorChecksumMismatch),
+		errors.Is(err, zzdm.ErrorSignature), errors.Is(err, zzdm.ErrorUnsigned), errors.Is(err, zzdm.ErrorUntrusted),
+		errors.Is(err, zzdm.ErrorShareMismatch), errors.Is(err, zzdm.ErrorShareMissing):
+		return codeAuth
+	case errors.Is(err, zzdm.ErrorInvalidFile), errors.Is(err, zzdm.ErrorFormat), errors.Is(err, zzdm.ErrorArmor),
+		errors.Is(err, zzdm.ErrorFrameMissing), errors.Is(err, zzdm.ErrorDataMissing), errors.Is(err, zzdm.ErrorInvalidData):
+		return codeInvalid
+	case errors.Is(err, zzdm.ErrorPolicy), errors.Is(err, zzdm.ErrorBreached):
+		return codeRejected
+	case errors.Is(err, zzdm.ErrorConfigKey), errors.Is(err, zzdm.ErrorConfigValue), errors.Is(err, zzdm.ErrorConfigProject):
+		return codeConfig
+	case os.IsNotExist(err):
+		return codeInput
+	}
+	return codeFailed
+}
+
+//输出错误并以对应的退出码退出
+func fail(code, message string) {
+	if jsonMode() {
+		emit(&result{Input: input, Code: code, Message: message, Duration: time.Since(started).Seconds()})
+	} else {
+		fmt.Println(message)
+	}
+	os.Exit(exitCodes[code])
+}
+
+//输出命令的结果,文件的结果已经由fileResult输出时不再重复,失败时以对应的退出码退出
+func finish(err error, r *result) {
+	if r == nil {
+		r = &result{}
+	}
+	if jsonMode() && reported == 0 {
+		if len(r.Input) == 0 {
+			r.Input = input
+		}
+		r.Code = errorCode(err)
+		if err != nil {
+			r.Message = err.Error()
+		}
+		r.Duration = time.Since(started).Seconds()
+		emit(r)
+	} else if err != nil && !jsonMode() {
 		fmt.Printf("%v\n", err)
 	}
+	if err != nil {
+		os.Exit(exitCodes[errorCode(err)])
+	}
+}
+
+//位置参数与--files-from中的输入,都没有时返回nil,按单个文件处理-i
+//位置参数可以是不依赖shell的glob,如'**/*.csv'
+func batchInputs(cmd *cobra.Command, args []string) []string {
+	if len(args) == 0 && len(filesFrom) == 0 {
+		return nil
+	}
+	if recursive || textMode {
+		fail(codeUsage, "several inputs cannot be combined with --recursive or --text")
+	}
+	var inputs []string
+	if len(input) > 0 {
+		inputs = append(inputs, input)
+	}
+	for _, pattern := range args {
+		matches, err := zzdm.Glob(pattern)
+		if err != nil {
+			fail(codeInput, fmt.Sprintf("%s: %v", pattern, err))
+		}
+		if len(matches) == 0 {
+			fmt.Fprintf(messages, "no files match %s\n", pattern)
+		}
+		inputs = append(inputs, matches...)
+	}
+	if len(filesFrom) > 0 {
+		reader := io.Reader(os.Stdin)
+		if filesFrom != "-" {
+			file, err := os.Open(filesFrom)
+			if err != nil {
+				fail(codeInput, err.Error())
+			}
+			defer file.Close()
+			reader = file
+		}
+		paths, err := zzdm.ReadFileList(reader, nullList)
+		if err != nil {
+			fail(codeInput, err.Error())
+		}
+		inputs = append(inputs, paths...)
+	}
+	if len(inputs) == 0 {
+		fail(codeInput, "no input files")
+	}
+	if len(outputFile) > 0 {
+		fail(codeUsage, zzdm.ErrorOutputFile.Error())
+	}
+	checkOutput(cmd)
+	return inputs
+}
+
+//文件结果的错误代码
+func resultCode(r *zzdm.FileResult) string {
+	if r.Skipped {
+		return codeSkipped
+	}
+	return errorCode(r.Err)
+}
+
+//检查输出目录,不存在时创建,是文件时提示使用--output-file,指定了--output-file时不使用输出目录
+func checkOutput(cmd *cobra.Command) {
+	if len(outputFile) > 0 {
+		if cmd.Flags().Changed("output") {
+			fail(codeUsage, "--output and --output-file cannot be used together")
+		}
+		output = ""
+		return
+	}
+	if len(output) == 0 {
+		return
+	}
+	if zzdm.Exist(output) && !zzdm.IsDir(output) {
+		fail(codeUsage, fmt.Sprintf("%s is not a directory, use --output-file to name the output file", output))
+	}
+	err := os.MkdirAll(output, 0755)
+	if err != nil {
+		fail(codeFailed, err.Error())
+	}
+}
+
+//批量处理结束时输出汇总,json模式时每个文件已经输出了结果,有失败的文件时以第一个失败的错误代码退出
+func batchSummary(inputs []string, errs []error) {
+	var failure error
+	failed, skipped := 0, 0
+	table := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if !jsonMode() {
+		fmt.Fprintln(table, "STATUS\tINPUT\tOUTPUT\tBYTES\tTIME\tMESSAGE")
+	}
+	for i, path := range inputs {
+		err := errs[i]
+		if err != nil {
+			failed++
+			if failure == nil {
+				failure = err
+			}
+		}
+		r, ok := results[path]
+		if !ok {
+			//没有开始处理的文件
+			r = &zzdm.FileResult{Input: path, Err: err}
+			if jsonMode() {
+				emit(&result{Input: path, Code: errorCode(err), Message: err.Error()})
+			}
+		}
+		if r.Skipped {
+			skipped++
+		}
+		if jsonMode() {
+			continue
+		}
+		message := ""
+		if err != nil {
+			message = err.Error()
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%d\t%.2fs\t%s\n", resultCode(r), path, r.Output, r.Bytes, r.Duration.Seconds(), message)
+	}
+	if !jsonMode() {
+		table.Flush()
+		fmt.Printf("%d files, %d ok, %d skipped, %d failed\n", len(inputs), len(inputs)-failed-skipped, skipped, failed)
+	}
+	if failure != nil {
+		os.Exit(exitCodes[errorCode(failure)])
+	}
+}
+
+//--text的输出,json模式时先写入缓冲区,再作为结果的text输出
+func textOutput() (io.Writer, func() *result) {
+	if !jsonMode() {
+		return os.Stdout, func() *result { return nil }
+	}
+	buffer := &bytes.Buffer{}
+	return buffer, func() *result {
+		return &result{Details: map[string]string{"text": buffer.String()}}
+	}
+}
+
+//每个文件处理完成时记录结果,json模式时输出结果,批量处理时并发调用
+func fileResult(r *zzdm.FileResult) {
+	resultLock.Lock()
+	defer resultLock.Unlock()
+	results[r.Input] = r
+	if !jsonMode() {
+		if r.Skipped {
+			fmt.Fprintf(messages, "%s skipped, %s already exists\n", r.Input, r.Output)
+		}
+		return
+	}
+	reported++
+	out := &result{
+		Command:  r.Operation,
+		Input:    r.Input,
+		Output:   r.Output,
+		Bytes:    r.Bytes,
+		Frames:   r.Frames,
+		Duration: r.Duration.Seconds(),
+		Format:   r.Format,
+		Cipher:   r.Cipher,
+		Code:     resultCode(r),
+	}
+	if r.Err != nil {
+		out.Message = r.Err.Error()
+	}
+	emit(out)
+}
+
+//输入文件名也可能被用作口令
+func passwordInputs() []string {
+	var inputs []string
+	if len(input) > 0 {
+		name := filepath.Base(input)
+		inputs = append(inputs, name, strings.TrimSuffix(name, filepath.Ext(name)))
+	}
+	return inputs
+}
+
+func checkPassword(password string) *zzdm.PasswordStrength {
+	strength := zzdm.EstimatePassword(password, passwordInputs()...)
+	fmt.Fprintf(messages, "[PA]:Score %d/4, about 10^%.0f guesses, %s to crack offline\n", strength.Score, math.Log10(strength.Guesses), strength.CrackTime)
+	for _, problem := range strength.Problems {
+		fmt.Fprintf(messages, "[PA]:%s\n", problem)
+	}
+	for _, suggestion := range strength.Suggestions {
+		fmt.Fprintf(messages, "[PA]:Suggestion: %s\n", suggestion)
+	}
+	return strength
+}
+
+//在泄露口令列表中查找口令,找到时给出出现的次数
+func checkBreached(password string) bool {
+	breached := false
+	for _, path := range breaches {
+		list, err := zzdm.OpenBreachList(path)
+		if err != nil {
+			fail(codeInput, fmt.Sprintf("%s: %v", path, err))
+		}
+		found, count, err := list.Lookup(password)
+		list.Close()
+		if err != nil {
+			fail(codeInput, fmt.Sprintf("%s: %v", path, err))
+		}
+		if !found {
+			continue
+		}
+		breached = true
+		if count > 0 {
+			fmt.Fprintf(messages, "[PA]:Password was found %d times in %s\n", count, path)
+		} else {
+			fmt.Fprintf(messages, "[PA]:Password is probably in %s\n", path)
+		}
+	}
+	return breached
 }
 
-func checkPassword(password string) {
-	level := zzdm.PasswordLevel(password)
-	if level == 0 {
-		fmt.Println("[PA]:Perfect")
-	} else if level == -1 {
-		fmt.Println("[PA]:Password should contain uppercase letters")
-	} else if level == -2 {
-		fmt.Println("[PA]:Password should contain lowercase letters")
-	} else if level == -3 {
-		fmt.Println("[PA]:Password should contain numbers")
-	} else if level == -4 {
-		fmt.Println("[PA]:Password should be at least 8 characters in length")
-	} else if level == -5 {
-		fmt.Println("[PA]:Password should contain at least 1 special character(~`!@#$%^&*()_+-=[]{}|\\<,>.?/;:\"')")
+//读取分层配置并合并改变了的命令行参数,再把合并的结果写回参数
+func loadConfig(cmd *cobra.Command) {
+	loaded, err := zzdm.LoadConfig()
+	if err != nil {
+		fail(codeConfig, err.Error())
+	}
+	//项目配置文件可能来自上级目录,读取时提示
+	if project := loaded.ProjectFile(); len(project) > 0 {
+		fmt.Fprintf(os.Stderr, "using project configuration %s\n", project)
+	}
+	flags := cmd.Flags()
+	bindings := configFlags
+	if flags.Lookup("policy") != nil {
+		//策略文件先于单独的策略参数
+		if flags.Changed("policy") {
+			err = loaded.LoadPolicyFile(policyFile, "flag --policy "+policyFile)
+			if err != nil {
+				fail(codeConfig, err.Error())
+			}
+		}
+		bindings = append(bindings, policyConfigFlags...)
+		if flags.Changed("ban") {
+			err = loaded.SetList("policy.banned_words", append(loaded.Policy.BannedWords, banned...), "flag --ban")
+		}
+	}
+	if err == nil && flags.Changed("secret") {
+		naming := "original"
+		if secret {
+			naming = "secret"
+		}
+		err = loaded.Set("naming", naming, "flag --secret")
+	}
+	for _, binding := range bindings {
+		if err != nil {
+			break
+		}
+		flag := flags.Lookup(binding.flag)
+		if flag == nil || !flag.Changed {
+			continue
+		}
+		source := "flag --" + binding.flag
+		if flag.Value.Type() == "stringArray" {
+			list, _ := flags.GetStringArray(binding.flag)
+			err = loaded.SetList(binding.key, list, source)
+		} else {
+			err = loaded.Set(binding.key, flag.Value.String(), source)
+		}
+	}
+	if err == nil {
+		err = loaded.Validate()
+	}
+	if err != nil {
+		fail(codeConfig, err.Error())
 	}
+	config = loaded
+	output = config.Output
+	secret = config.NamingPolicy() == zzdm.NamingSecret
+	force = config.Force
+	breaches = config.BreachLists
+	recipients = config.Keys.Recipients
+	identities = config.Keys.Identities
+	signKey = config.Keys.SigningKey
+	signers = config.Keys.Signers
+}
 
+//加密前检查口令,在泄露列表中或违反策略时拒绝,指定--allow-weak时只给出警告
+func enforcePassword(cmd *cobra.Command, password string) {
+	if len(password) == 0 {
+		return
+	}
+	if checkBreached(password) && !breachWarn && !allowWeak {
+		fail(codeRejected, zzdm.ErrorBreached.Error())
+	}
+	err := config.Policy.Check(password, passwordInputs()...)
+	if err == nil {
+		return
+	}
+	if allowWeak {
+		fmt.Fprintf(messages, "[PA]:%v\n", err)
+		return
+	}
+	if jsonMode() {
+		fail(codeRejected, err.Error())
+	}
+	fmt.Println(err)
+	fmt.Println("use --allow-weak to encrypt with this password anyway")
+	os.Exit(exitCodes[codeRejected])
+}
+
+//解密后提醒超过使用期限的口令
+func warnPasswordAge(path string) {
+	if config.Policy.MaxAge <= 0 || recursive {
+		return
+	}
+	err := config.Policy.CheckFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[PA]:%v\n", err)
+	}
+}
+
+//口令策略的参数,解密只检查使用期限
+func policyFlags(command *cobra.Command, encrypting bool) {
+	command.PersistentFlags().StringVar(&policyFile, "policy", "", "TOML password policy with min_length, min_entropy, classes, banned_words and max_age")
+	if !encrypting {
+		command.PersistentFlags().StringVar(&maxAge, "max-age", "", "warn when the password of the file is older than this, like 90d")
+		return
+	}
+	command.PersistentFlags().IntVar(&minLength, "min-length", 0, "minimum password length")
+	command.PersistentFlags().Float64Var(&minEntropy, "min-entropy", 0, "minimum estimated password entropy in bits")
+	command.PersistentFlags().StringArrayVar(&classes, "require", nil, "character class the password must contain: upper, lower, digit or symbol, can be repeated")
+	command.PersistentFlags().StringArrayVar(&banned, "ban", nil, "word the password must not contain, can be repeated")
 }
 
 func parseFlag(command *cobra.Command, classify int) {
 	if classify == ROOT {
 		command.PersistentFlags().BoolVarP(&version, "version", "v", false, "display version info")
+		command.PersistentFlags().StringVar(&outFormat, "output-format", formatText, "text, or json for one JSON result per line on stdout")
+		return
+	}
+	command.Annotations = map[string]string{configAnnotation: ""}
+	if classify == VERIFICATION {
+		command.PersistentFlags().StringVarP(&input, "input", "i", "", "input file")
+		command.PersistentFlags().StringVarP(&password, "password", "p", "", "password")
 	} else {
 		command.PersistentFlags().StringVarP(&input, "input", "i", "", "input file")
 		command.PersistentFlags().StringVarP(&output, "output", "o", "", "output directory")
 		command.PersistentFlags().StringVarP(&password, "password", "p", "", "password")
 		command.PersistentFlags().BoolVarP(&force, "force", "f", false, "force to overwrite an existing  file within the output directory")
+		command.PersistentFlags().BoolVar(&noPreserve, "no-preserve", false, "do not record/restore mode, timestamps, ownership and xattrs")
+		command.PersistentFlags().StringVar(&outputFile, "output-file", "", "write to this file instead of a file named after the input in the output directory")
+		command.PersistentFlags().StringVar(&conflict, "on-conflict", "error", "when the output exists: error, overwrite, skip, rename to \"name (1)\" or newer to overwrite only older outputs")
+		if classify == SALVAGE {
+			command.PersistentFlags().BoolVar(&zeroFill, "zero-fill", false, "write zeros over the lost ranges instead of leaving holes")
+			return
+		}
+		command.PersistentFlags().BoolVarP(&recursive, "recursive", "r", false, "process a directory recursively into the output directory")
+		command.PersistentFlags().StringVar(&filesFrom, "files-from", "", "read the input paths from this file, - for stdin, one per line")
+		command.PersistentFlags().BoolVar(&nullList, "null", false, "paths of --files-from are separated by NUL instead of newlines")
+		command.PersistentFlags().IntVarP(&jobs, "jobs", "j", runtime.NumCPU(), "number of files processed at the same time in batch mode")
+		command.PersistentFlags().BoolVar(&resume, "resume", false, "continue an interrupted run from the last complete frame of the existing output")
+		if classify == DECRYPTION {
+			command.PersistentFlags().BoolVar(&textMode, "text", false, "print the plaintext of an armored message to stdout")
+			command.PersistentFlags().StringVar(&format, "format", zzdm.FormatZZDM, "input format: zzdm, age or openssl, detected automatically")
+			command.PersistentFlags().StringArrayVar(&identities, "identity", nil, "age identity file, can be repeated")
+			command.PersistentFlags().IntVar(&iter, "iter", zzdm.OpenSSLCost, "PBKDF2 iterations of an openssl file, 0 for EVP_BytesToKey")
+			command.PersistentFlags().StringVar(&digest, "md", zzdm.DigestSHA256, "digest of an openssl file: sha256, sha1 or md5")
+			command.PersistentFlags().StringVar(&cipher, "cipher", zzdm.CipherAES256CBC, "cipher of an openssl file: aes-256-cbc, aes-192-cbc or aes-128-cbc")
+			command.PersistentFlags().StringArrayVar(&signers, "signer", nil, "file of trusted Ed25519 public keys, the file must be signed by one of them")
+			command.PersistentFlags().StringArrayVar(&shareFiles, "share", nil, "key share file written by encrypt --shares, repeat until the threshold is reached")
+			policyFlags(command, false)
+		}
 		if classify == ENCRYPTION {
-			command.PersistentFlags().BoolVarP(&advice, "advice", "a", false, "show password advice")
-			command.PersistentFlags().BoolVarP(&secret, "secret", "s", false, "random output file name")
+			command.PersistentFlags().BoolVarP(&advice, "advice", "a", false, "show the estimated password strength and its problems")
+			command.PersistentFlags().BoolVarP(&secret, "secret", "s", false, "encrypt the output file name with the password, the same name and password always give the same output name; the name key uses a fixed salt, so names encrypted with a weak password can be cracked with one precomputed dictionary")
+			command.PersistentFlags().StringVar(&padding, "padding", zzdm.PaddingNone, "hide the file size by padding: none, pow2, padme or bucket")
+			command.PersistentFlags().Int64Var(&bucket, "bucket", 1<<20, "bucket size in bytes for --padding bucket")
+			command.PersistentFlags().BoolVar(&armor, "armor", false, "write a PEM-like text block instead of binary")
+			command.PersistentFlags().StringVar(&format, "format", zzdm.FormatZZDM, "output format: zzdm, age or openssl")
+			command.PersistentFlags().StringVar(&cipher, "cipher", zzdm.CipherAES256CBC, "cipher suite: aes-256-cbc, aes-192-cbc or aes-128-cbc")
+			command.PersistentFlags().IntVar(&kdfCost, "kdf-cost", zzdm.DefaultKDFCost, "PBKDF2 iterations of the password")
+			command.PersistentFlags().Int64Var(&frameSize, "frame-size", zzdm.BUFFER, "plaintext bytes per frame")
+			command.PersistentFlags().StringArrayVar(&recipients, "recipient", nil, "age X25519 public key, can be repeated, the password is not used")
+			command.PersistentFlags().IntVar(&iter, "iter", zzdm.OpenSSLCost, "PBKDF2 iterations for --format openssl, 0 for EVP_BytesToKey")
+			command.PersistentFlags().StringVar(&digest, "md", zzdm.DigestSHA256, "digest for --format openssl: sha256, sha1 or md5")
+			command.PersistentFlags().StringVar(&signKey, "sign-key", "", "sign the output with this Ed25519 private key from zzdm keygen")
+			command.PersistentFlags().IntVar(&shares, "shares", 0, "split a random file key into this many share files next to the output")
+			command.PersistentFlags().IntVar(&threshold, "threshold", 0, "number of shares needed to decrypt, at least 2")
+			command.PersistentFlags().StringArrayVar(&breaches, "breach-list", nil, "refuse passwords found in this sorted SHA-1 list or bloom filter, can be repeated")
+			command.PersistentFlags().BoolVar(&breachWarn, "breach-warn", false, "only warn about breached passwords instead of refusing them")
+			command.PersistentFlags().BoolVar(&allowWeak, "allow-weak", false, "only warn about passwords that violate the policy or are breached")
+			policyFlags(command, true)
+			command.PersistentFlags().StringVar(&text, "text", "", "encrypt a short text and print it as an armored message")
+			command.PersistentFlags().IntVar(&parity, "parity", 0, "write parity frames after every group of this many data frames")
+			command.PersistentFlags().IntVar(&shards, "parity-shards", zzdm.DefaultParityShards, "parity frames per group, the number of damaged frames a group can recover")
+			command.PersistentFlags().BoolVar(&removeSrc, "remove-source", false, "after checking that the output decrypts to the source, overwrite the source with random data and delete it")
+			command.PersistentFlags().BoolVar(&removeSrc, "shred", false, "same as --remove-source")
+			command.PersistentFlags().IntVar(&passes, "shred-passes", zzdm.DefaultShredPasses, "passes of random data written over the source before it is deleted")
 		}
 
 	}
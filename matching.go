@@ -0,0 +1,632 @@
+package zzdm
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+//口令中可识别的模式
+const (
+	PatternDictionary = "dictionary"
+	PatternSpatial    = "spatial"
+	PatternRepeat     = "repeat"
+	PatternSequence   = "sequence"
+	PatternYear       = "year"
+	PatternDate       = "date"
+	//无法识别的部分按穷举计算
+	PatternBruteforce = "bruteforce"
+)
+
+//字典的名称
+const (
+	DictionaryPasswords   = "passwords"
+	DictionaryEnglish     = "english"
+	DictionaryMaleNames   = "male_names"
+	DictionaryFemaleNames = "female_names"
+	DictionarySurnames    = "surnames"
+	//调用者给出的个人信息,如用户名与文件名
+	DictionaryUserInputs = "user_inputs"
+)
+
+const (
+	dateMinYear = 1000
+	dateMaxYear = 2050
+	//序列中相邻字符的最大差值
+	sequenceMaxDelta = 5
+	//字符替换组合的上限,避免替换字符很多时组合数爆炸
+	maxL33tSubs = 64
+)
+
+//按键布局,每个按键的两个字符分别为未按shift与按下shift时的字符
+const qwertyLayout = `
+` + "`~" + ` 1! 2@ 3# 4$ 5% 6^ 7& 8* 9( 0) -_ =+
+    qQ wW eE rR tT yY uU iI oO pP [{ ]} \|
+     aA sS dD fF gG hH jJ kK lL ;: '"
+      zZ xX cC vV bB nN mM ,< .> /?
+`
+
+const dvorakLayout = `
+` + "`~" + ` 1! 2@ 3# 4$ 5% 6^ 7& 8* 9( 0) [{ ]}
+    '" ,< .> pP yY fF gG cC rR lL /? =+ \|
+     aA oO eE uU iI dD hH tT nN sS -_
+      ;: qQ jJ kK xX bB mM wW vV zZ
+`
+
+const keypadLayout = `
+  / * -
+7 8 9 +
+4 5 6
+1 2 3
+  0 .
+`
+
+//需要按下shift的字符
+const shiftedKeys = "~!@#$%^&*()_+QWERTYUIOP{}|ASDFGHJKL:\"ZXCVBNM<>?"
+
+//常见的字符替换,字母与可以代替它的字符
+var l33tTable = []struct {
+	letter rune
+	subs   string
+}{
+	{'a', "4@"},
+	{'b', "8"},
+	{'c', "({[<"},
+	{'e', "3"},
+	{'g', "69"},
+	{'i', "1!|"},
+	{'l', "1|7"},
+	{'o', "0"},
+	{'s', "$5"},
+	{'t', "+7"},
+	{'x', "%"},
+	{'z', "2"},
+}
+
+var (
+	recentYearRegex   = regexp.MustCompile(`19\d\d|20[0-4]\d`)
+	dateSeparateRegex = regexp.MustCompile(`^(\d{1,4})([\s/\\_.-])(\d{1,2})([\s/\\_.-])(\d{1,4})$`)
+	//不带分隔符的日期中日月年的可能划分
+	dateSplits = map[int][][2]int{
+		4: {{1, 2}, {2, 3}},
+		5: {{1, 3}, {2, 3}},
+		6: {{1, 2}, {2, 4}, {4, 5}},
+		7: {{1, 3}, {2, 3}, {4, 5}, {4, 6}},
+		8: {{2, 4}, {4, 6}},
+	}
+)
+
+//按常见程度排序的字典
+type rankedDictionary struct {
+	name  string
+	ranks map[string]int
+	//最长的词,用于限制查找的范围
+	longest int
+}
+
+func newRankedDictionary(name string, words []string) *rankedDictionary {
+	d := &rankedDictionary{name: name, ranks: make(map[string]int, len(words))}
+	for _, word := range words {
+		word = string(lowerRunes([]rune(word)))
+		if _, ok := d.ranks[word]; ok || len(word) == 0 {
+			continue
+		}
+		d.ranks[word] = len(d.ranks) + 1
+		if length := utf8.RuneCountInString(word); length > d.longest {
+			d.longest = length
+		}
+	}
+	return d
+}
+
+//键盘上的相邻关系
+type keyboardGraph struct {
+	name string
+	//每个字符在各个方向上相邻的按键,没有按键时为空
+	adjacent map[rune][]string
+	//起始按键的数量与平均相邻按键数
+	starts int
+	degree float64
+	//是否有shift字符
+	shifted bool
+}
+
+//由按键布局计算相邻关系,键盘的每行错开,数字小键盘的各行对齐
+func newKeyboardGraph(name, layout string, slanted bool) *keyboardGraph {
+	type position struct{ x, y int }
+	keys := make(map[position]string)
+	for y, line := range strings.Split(layout, "\n") {
+		slant := 0
+		if slanted {
+			slant = y - 1
+		}
+		for _, token := range strings.Fields(line) {
+			unit := len(token) + 1
+			keys[position{(strings.Index(line, token) - slant) / unit, y}] = token
+		}
+	}
+	directions := [][2]int{{-1, 0}, {0, -1}, {1, -1}, {1, 0}, {0, 1}, {-1, 1}}
+	if !slanted {
+		directions = [][2]int{{-1, 0}, {-1, -1}, {0, -1}, {1, -1}, {1, 0}, {1, 1}, {0, 1}, {-1, 1}}
+	}
+	g := &keyboardGraph{name: name, adjacent: make(map[rune][]string), shifted: slanted}
+	neighbors := 0
+	for p, token := range keys {
+		for _, c := range token {
+			adjacent := make([]string, len(directions))
+			for i, d := range directions {
+				adjacent[i] = keys[position{p.x + d[0], p.y + d[1]}]
+				if len(adjacent[i]) > 0 {
+					neighbors++
+				}
+			}
+			g.adjacent[c] = adjacent
+		}
+	}
+	g.starts = len(g.adjacent)
+	g.degree = float64(neighbors) / float64(len(g.adjacent))
+	return g
+}
+
+var (
+	matchingOnce sync.Once
+	dictionaries []*rankedDictionary
+	keyboards    []*keyboardGraph
+)
+
+//字典与键盘只在第一次估计时构建
+func loadMatching() {
+	matchingOnce.Do(func() {
+		dictionaries = []*rankedDictionary{
+			newRankedDictionary(DictionaryPasswords, strings.Fields(passwordsList)),
+			newRankedDictionary(DictionaryEnglish, strings.Fields(englishList)),
+			newRankedDictionary(DictionaryMaleNames, strings.Fields(maleNamesList)),
+			newRankedDictionary(DictionaryFemaleNames, strings.Fields(femaleNamesList)),
+			newRankedDictionary(DictionarySurnames, strings.Fields(surnamesList)),
+		}
+		keyboards = []*keyboardGraph{
+			newKeyboardGraph("qwerty", qwertyLayout, true),
+			newKeyboardGraph("dvorak", dvorakLayout, true),
+			newKeyboardGraph("keypad", keypadLayout, false),
+		}
+	})
+}
+
+//找出口令中所有可识别的模式,按位置排序
+func omnimatch(password []rune, user *rankedDictionary) []*PasswordMatch {
+	ranked := append([]*rankedDictionary{user}, dictionaries...)
+	var matches []*PasswordMatch
+	matches = append(matches, dictionaryMatch(password, ranked)...)
+	matches = append(matches, reverseDictionaryMatch(password, ranked)...)
+	matches = append(matches, l33tMatch(password, ranked)...)
+	matches = append(matches, spatialMatch(password)...)
+	matches = append(matches, repeatMatch(password, user)...)
+	matches = append(matches, sequenceMatch(password)...)
+	matches = append(matches, yearMatch(password)...)
+	matches = append(matches, dateMatch(password)...)
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Start != matches[j].Start {
+			return matches[i].Start < matches[j].Start
+		}
+		return matches[i].End < matches[j].End
+	})
+	return matches
+}
+
+//逐个字符转为小写,保持长度不变
+func lowerRunes(s []rune) []rune {
+	lower := make([]rune, len(s))
+	for i, c := range s {
+		lower[i] = unicode.ToLower(c)
+	}
+	return lower
+}
+
+func reverseRunes(s []rune) []rune {
+	reversed := make([]rune, len(s))
+	for i, c := range s {
+		reversed[len(s)-1-i] = c
+	}
+	return reversed
+}
+
+//字典中的词
+func dictionaryMatch(password []rune, ranked []*rankedDictionary) []*PasswordMatch {
+	lower := lowerRunes(password)
+	var matches []*PasswordMatch
+	for _, d := range ranked {
+		for i := range lower {
+			for j := i; j < len(lower) && j-i < d.longest; j++ {
+				word := string(lower[i : j+1])
+				rank, ok := d.ranks[word]
+				if !ok {
+					continue
+				}
+				matches = append(matches, &PasswordMatch{
+					Pattern:    PatternDictionary,
+					Start:      i,
+					End:        j,
+					Token:      string(password[i : j+1]),
+					Word:       word,
+					Dictionary: d.name,
+					Rank:       rank,
+				})
+			}
+		}
+	}
+	return matches
+}
+
+//反转的字典词,回文与正向的匹配相同,不再重复
+func reverseDictionaryMatch(password []rune, ranked []*rankedDictionary) []*PasswordMatch {
+	n := len(password)
+	var matches []*PasswordMatch
+	for _, m := range dictionaryMatch(reverseRunes(password), ranked) {
+		token := reverseRunes([]rune(m.Token))
+		if string(lowerRunes(token)) == m.Word {
+			continue
+		}
+		m.Token = string(token)
+		m.Reversed = true
+		m.Start, m.End = n-1-m.End, n-1-m.Start
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+//口令中出现的替换字符的所有还原方式,一个字符可能代替多个字母时分别尝试
+func l33tSubs(password []rune) []map[rune]rune {
+	candidates := make(map[rune][]rune)
+	var chars []rune
+	for _, entry := range l33tTable {
+		for _, c := range entry.subs {
+			if !strings.ContainsRune(string(password), c) {
+				continue
+			}
+			if _, ok := candidates[c]; !ok {
+				chars = append(chars, c)
+			}
+			candidates[c] = append(candidates[c], entry.letter)
+		}
+	}
+	subs := []map[rune]rune{{}}
+	for _, c := range chars {
+		next := make([]map[rune]rune, 0, len(subs)*len(candidates[c]))
+		for _, sub := range subs {
+			for _, letter := range candidates[c] {
+				extended := make(map[rune]rune, len(sub)+1)
+				for k, v := range sub {
+					extended[k] = v
+				}
+				extended[c] = letter
+				next = append(next, extended)
+			}
+		}
+		subs = next
+		if len(subs) > maxL33tSubs {
+			subs = subs[:maxL33tSubs]
+		}
+	}
+	return subs
+}
+
+//用数字或符号代替字母的字典词,少于3个字符的匹配噪声太大,忽略
+func l33tMatch(password []rune, ranked []*rankedDictionary) []*PasswordMatch {
+	var matches []*PasswordMatch
+	seen := make(map[string]bool)
+	for _, sub := range l33tSubs(password) {
+		if len(sub) == 0 {
+			continue
+		}
+		subbed := make([]rune, len(password))
+		for i, c := range password {
+			subbed[i] = c
+			if letter, ok := sub[c]; ok {
+				subbed[i] = letter
+			}
+		}
+		for _, m := range dictionaryMatch(subbed, ranked) {
+			token := password[m.Start : m.End+1]
+			if len(token) < 3 || string(lowerRunes(token)) == m.Word {
+				continue
+			}
+			key := fmt.Sprintf("%s %d %d %s", m.Dictionary, m.Start, m.End, m.Word)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			used := make(map[rune]rune)
+			for _, c := range token {
+				if letter, ok := sub[c]; ok {
+					used[c] = letter
+				}
+			}
+			m.Token = string(token)
+			m.L33t = true
+			m.Sub = used
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+//键盘上连续相邻的按键,至少3个字符
+func spatialMatch(password []rune) []*PasswordMatch {
+	var matches []*PasswordMatch
+	for _, g := range keyboards {
+		for i := 0; i < len(password)-1; {
+			j := i + 1
+			last, turns, shifted := -1, 0, 0
+			if g.shifted && strings.ContainsRune(shiftedKeys, password[i]) {
+				shifted = 1
+			}
+			for ; j < len(password); j++ {
+				found := -1
+				for direction, key := range g.adjacent[password[j-1]] {
+					if k := strings.IndexRune(key, password[j]); k >= 0 {
+						found = direction
+						if k > 0 {
+							shifted++
+						}
+						break
+					}
+				}
+				if found < 0 {
+					break
+				}
+				if found != last {
+					turns++
+					last = found
+				}
+			}
+			if j-i > 2 {
+				matches = append(matches, &PasswordMatch{
+					Pattern: PatternSpatial,
+					Start:   i,
+					End:     j - 1,
+					Token:   string(password[i:j]),
+					Graph:   g.name,
+					Turns:   turns,
+					Shifted: shifted,
+					graph:   g,
+				})
+			}
+			i = j
+		}
+	}
+	return matches
+}
+
+func equalRunes(a, b []rune) bool {
+	return string(a) == string(b)
+}
+
+//重复的字符或片段,取覆盖最长的重复,长度相同时取最短的重复单元
+func repeatMatch(password []rune, user *rankedDictionary) []*PasswordMatch {
+	var matches []*PasswordMatch
+	n := len(password)
+	for i := 0; i < n; {
+		base, count := 0, 0
+		for size := 1; i+2*size <= n; size++ {
+			c := 1
+			for i+(c+1)*size <= n && equalRunes(password[i:i+size], password[i+c*size:i+(c+1)*size]) {
+				c++
+			}
+			if c >= 2 && size*c > base*count {
+				base, count = size, c
+			}
+		}
+		if base == 0 {
+			i++
+			continue
+		}
+		end := i + base*count
+		guesses, _ := mostGuessable(password[i:i+base], user)
+		matches = append(matches, &PasswordMatch{
+			Pattern:     PatternRepeat,
+			Start:       i,
+			End:         end - 1,
+			Token:       string(password[i:end]),
+			Base:        string(password[i : i+base]),
+			Repeat:      count,
+			baseGuesses: guesses,
+		})
+		i = end
+	}
+	return matches
+}
+
+//相邻字符的编码差值固定的序列,如abc、7531
+func sequenceMatch(password []rune) []*PasswordMatch {
+	var matches []*PasswordMatch
+	if len(password) < 2 {
+		return matches
+	}
+	update := func(i, j, delta int) {
+		ascending := delta > 0
+		delta = abs(delta)
+		if delta == 0 || delta > sequenceMaxDelta || (j-i <= 1 && delta != 1) {
+			return
+		}
+		matches = append(matches, &PasswordMatch{Pattern: PatternSequence, Start: i, End: j, Token: string(password[i : j+1]), Ascending: ascending})
+	}
+	i := 0
+	last := int(password[1] - password[0])
+	for k := 2; k < len(password); k++ {
+		delta := int(password[k] - password[k-1])
+		if delta == last {
+			continue
+		}
+		update(i, k-1, last)
+		i = k - 1
+		last = delta
+	}
+	update(i, len(password)-1, last)
+	return matches
+}
+
+//字符位置与字节位置的转换,年份与日期只包含ASCII字符
+func runeIndex(s string, index int) int {
+	return utf8.RuneCountInString(s[:index])
+}
+
+//最近的年份
+func yearMatch(password []rune) []*PasswordMatch {
+	var matches []*PasswordMatch
+	s := string(password)
+	for _, loc := range recentYearRegex.FindAllStringIndex(s, -1) {
+		year, _ := strconv.Atoi(s[loc[0]:loc[1]])
+		matches = append(matches, &PasswordMatch{
+			Pattern: PatternYear,
+			Start:   runeIndex(s, loc[0]),
+			End:     runeIndex(s, loc[1]) - 1,
+			Token:   s[loc[0]:loc[1]],
+			Year:    year,
+		})
+	}
+	return matches
+}
+
+func isDigits(s []rune) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+//日期,带分隔符或不带分隔符,年份可以是2位或4位
+//同一位置有多种解释时取年份最接近今年的,被更长的日期包含的匹配去掉
+func dateMatch(password []rune) []*PasswordMatch {
+	var matches []*PasswordMatch
+	reference := time.Now().Year()
+	closest := func(a, b *PasswordMatch) bool {
+		return abs(a.Year-reference) < abs(b.Year-reference)
+	}
+	for i := 0; i+4 <= len(password); i++ {
+		for j := i + 3; j <= i+7 && j < len(password); j++ {
+			token := password[i : j+1]
+			if !isDigits(token) {
+				break
+			}
+			var best *PasswordMatch
+			for _, split := range dateSplits[len(token)] {
+				a, _ := strconv.Atoi(string(token[:split[0]]))
+				b, _ := strconv.Atoi(string(token[split[0]:split[1]]))
+				c, _ := strconv.Atoi(string(token[split[1]:]))
+				day, month, year, ok := mapDate(a, b, c)
+				if !ok {
+					continue
+				}
+				candidate := &PasswordMatch{Pattern: PatternDate, Start: i, End: j, Token: string(token), Year: year, Month: month, Day: day}
+				if best == nil || closest(candidate, best) {
+					best = candidate
+				}
+			}
+			if best != nil {
+				matches = append(matches, best)
+			}
+		}
+	}
+	for i := 0; i+6 <= len(password); i++ {
+		for j := i + 5; j <= i+9 && j < len(password); j++ {
+			token := string(password[i : j+1])
+			groups := dateSeparateRegex.FindStringSubmatch(token)
+			if groups == nil || groups[2] != groups[4] {
+				continue
+			}
+			a, _ := strconv.Atoi(groups[1])
+			b, _ := strconv.Atoi(groups[3])
+			c, _ := strconv.Atoi(groups[5])
+			day, month, year, ok := mapDate(a, b, c)
+			if !ok {
+				continue
+			}
+			matches = append(matches, &PasswordMatch{Pattern: PatternDate, Start: i, End: j, Token: token, Separator: groups[2], Year: year, Month: month, Day: day})
+		}
+	}
+	result := matches[:0:0]
+	for _, m := range matches {
+		contained := false
+		for _, other := range matches {
+			if other != m && other.Start <= m.Start && other.End >= m.End {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+//将三个数解释为日月年,年份在开头或末尾,4位的年份优先
+func mapDate(a, b, c int) (day, month, year int, ok bool) {
+	if b > 31 || b <= 0 {
+		return
+	}
+	over12, over31, under1 := 0, 0, 0
+	for _, n := range []int{a, b, c} {
+		if (n > 99 && n < dateMinYear) || n > dateMaxYear {
+			return
+		}
+		if n > 31 {
+			over31++
+		}
+		if n > 12 {
+			over12++
+		}
+		if n <= 0 {
+			under1++
+		}
+	}
+	if over31 >= 2 || over12 == 3 || under1 >= 2 {
+		return
+	}
+	splits := [][3]int{{c, a, b}, {a, b, c}}
+	for _, split := range splits {
+		if split[0] >= dateMinYear && split[0] <= dateMaxYear {
+			day, month, ok = mapDayMonth(split[1], split[2])
+			return day, month, split[0], ok
+		}
+	}
+	for _, split := range splits {
+		day, month, ok = mapDayMonth(split[1], split[2])
+		if ok {
+			year = split[0]
+			if year <= 99 {
+				if year > 50 {
+					year += 1900
+				} else {
+					year += 2000
+				}
+			}
+			return day, month, year, true
+		}
+	}
+	return
+}
+
+func mapDayMonth(a, b int) (day, month int, ok bool) {
+	for _, dm := range [][2]int{{a, b}, {b, a}} {
+		if dm[0] >= 1 && dm[0] <= 31 && dm[1] >= 1 && dm[1] <= 12 {
+			return dm[0], dm[1], true
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,97 @@
+package zzdm
+
+import (
+	"crypto/rand"
+	"log"
+	"os"
+	"time"
+)
+
+//需要保存的权限位
+const modeMask = os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky
+
+//读取文件的权限、时间、所有者与扩展属性
+func fileMetadata(path string) (*Metadata, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	meta := &Metadata{
+		Mode:  uint32(info.Mode() & modeMask),
+		Mtime: info.ModTime().UnixNano(),
+	}
+	statMetadata(path, meta)
+	meta.Xattrs, err = readXattrs(path)
+	if err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+//恢复解密后文件的元数据,所有者与扩展属性失败时只记录日志
+func restoreMetadata(path string, meta *Metadata, logger *log.Logger) error {
+	err := writeXattrs(path, meta.Xattrs)
+	if err != nil {
+		logger.Printf("restore xattrs of %s: %v", path, err)
+	}
+	err = restoreOwner(path, meta)
+	if err != nil {
+		logger.Printf("restore owner of %s: %v", path, err)
+	}
+	if meta.Mode != 0 {
+		err = os.Chmod(path, os.FileMode(meta.Mode))
+		if err != nil {
+			return err
+		}
+	}
+	if meta.Mtime != 0 {
+		atime := meta.Atime
+		if atime == 0 {
+			atime = meta.Mtime
+		}
+		err = os.Chtimes(path, time.Unix(0, atime), time.Unix(0, meta.Mtime))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//加密元数据,随机向量保存在密文之前
+//元数据总是加密保存,--secret时文件名同样只以密文出现在文件头中
+func sealMetadata(meta *Metadata, key []byte) ([]byte, error) {
+	message, err := meta.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, 16)
+	_, err = rand.Read(iv)
+	if err != nil {
+		return nil, err
+	}
+	data, err := AesEncrypt(message, key, iv)
+	if err != nil {
+		return nil, err
+	}
+	return append(iv, data...), nil
+}
+
+//解密文件头中的元数据,没有元数据时返回nil
+func openMetadata(header *Header, key []byte) (*Metadata, error) {
+	if len(header.Meta) == 0 {
+		return nil, nil
+	}
+	if len(header.Meta) <= 16 {
+		return nil, ErrorInvalidFile
+	}
+	message, err := AesDecrypt(header.Meta[16:], key, header.Meta[:16])
+	if err != nil {
+		return nil, err
+	}
+	meta := &Metadata{}
+	err = meta.Unmarshal(message)
+	if err != nil {
+		return nil, ErrorInvalidFile
+	}
+	return meta, nil
+}
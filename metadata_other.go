@@ -0,0 +1,19 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package zzdm
+
+func statMetadata(path string, meta *Metadata) {
+}
+
+func readXattrs(path string) ([]*XAttr, error) {
+	return nil, nil
+}
+
+func writeXattrs(path string, attrs []*XAttr) error {
+	return nil
+}
+
+func restoreOwner(path string, meta *Metadata) error {
+	return nil
+}
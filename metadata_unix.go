@@ -0,0 +1,72 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package zzdm
+
+import (
+	"os"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+//读取访问时间与所有者
+func statMetadata(path string, meta *Metadata) {
+	var stat unix.Stat_t
+	if unix.Stat(path, &stat) != nil {
+		return
+	}
+	meta.Atime = stat.Atim.Nano()
+	meta.Owner = true
+	meta.Uid = stat.Uid
+	meta.Gid = stat.Gid
+}
+
+//读取扩展属性,文件系统不支持时返回空
+func readXattrs(path string) ([]*XAttr, error) {
+	size, err := unix.Listxattr(path, nil)
+	if err != nil || size <= 0 {
+		return nil, nil
+	}
+	buffer := make([]byte, size)
+	size, err = unix.Listxattr(path, buffer)
+	if err != nil {
+		return nil, err
+	}
+	attrs := make([]*XAttr, 0)
+	for _, name := range strings.Split(string(buffer[:size]), "\x00") {
+		if len(name) == 0 {
+			continue
+		}
+		length, err := unix.Getxattr(path, name, nil)
+		if err != nil {
+			return nil, err
+		}
+		value := make([]byte, length)
+		length, err = unix.Getxattr(path, name, value)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, &XAttr{Name: name, Value: value[:length]})
+	}
+	return attrs, nil
+}
+
+//写入扩展属性
+func writeXattrs(path string, attrs []*XAttr) error {
+	for _, attr := range attrs {
+		err := unix.Setxattr(path, attr.Name, attr.Value, 0)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//恢复所有者,非root用户通常没有权限
+func restoreOwner(path string, meta *Metadata) error {
+	if !meta.Owner {
+		return nil
+	}
+	return os.Chown(path, int(meta.Uid), int(meta.Gid))
+}
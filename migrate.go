@@ -0,0 +1,96 @@
+package zzdm
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+//统计写入的字节数
+type countWriter struct {
+	count int64
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	w.count += int64(len(p))
+	return len(p), nil
+}
+
+//将旧格式的加密文件就地转换为当前格式,明文不会写入磁盘
+//第一遍校验全部帧并统计明文长度,第二遍边解密边重新加密,完成后替换原文件
+func (e *Encryptor) Migrate(ctx context.Context, input, password string) error {
+	//转换时沿用口令派生的密钥,不会生成份额
+	if e.options.Shares > 0 {
+		return ErrorShareOutput
+	}
+	file, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	header, err := ReadHead(file)
+	if err != nil {
+		return err
+	}
+	if header == nil {
+		return ErrorFileIO
+	}
+	if header.Version >= FormatVersion {
+		return nil
+	}
+	keys, err := headerKeys(header, password)
+	if err != nil {
+		return err
+	}
+	name, err := headerName(header, keys.data, password, nil)
+	if err != nil {
+		return err
+	}
+	decryptor := NewDecryptor(WithConcurrency(e.options.Concurrency))
+	counter := &countWriter{}
+	err = decryptor.decryptFrames(ctx, file, counter, header, keys, nil, newSignatureDigest(header), 0)
+	if err != nil {
+		return err
+	}
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	header, err = ReadHead(file)
+	if err != nil {
+		return err
+	}
+
+	temp, err := ioutil.TempFile(filepath.Dir(input), ".zzdm-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	defer temp.Close()
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(decryptor.decryptFrames(ctx, file, writer, header, keys, nil, newSignatureDigest(header), 0))
+	}()
+	options := e.options
+	options.Naming = NamingOriginal
+	if header.Secret {
+		options.Naming = NamingSecret
+	}
+	encryptor := &Encryptor{options}
+	err = encryptor.encryptStream(ctx, reader, temp, name, password, counter.count, &Metadata{}, nil, nil)
+	reader.CloseWithError(err)
+	if err != nil {
+		return err
+	}
+	err = temp.Close()
+	if err != nil {
+		return err
+	}
+	if info, err := os.Stat(input); err == nil {
+		os.Chmod(temp.Name(), info.Mode())
+	}
+	e.options.Logger.Printf("migrate %s to format version %d", input, FormatVersion)
+	return os.Rename(temp.Name(), input)
+}
@@ -0,0 +1,88 @@
+package zzdm
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	//文件头中的文件名使用确定性加密
+	NamingSIV = "siv"
+	//文件名密钥的派生参数,文件名需要在没有文件头时(例如目录名)也能解密,因此不使用随机盐
+	//这是已知的取舍:相同的密码在所有文件与所有用户之间得到相同的文件名密钥,
+	//一次预计算即可对所有加密的文件名进行字典攻击,文件内容的密钥不受影响,仍使用随机盐
+	nameSalt = "zzdm/name"
+	nameCost = 100000
+)
+
+//文件名只使用小写字母与数字
+var nameEncoding = base32.HexEncoding.WithPadding(base32.NoPadding)
+
+//确定性的文件名加密,相同的密码与文件名总是得到相同的结果
+//向量为HMAC-SHA256(文件名)的前16个字节,再以AES-CTR加密文件名,解密时重新计算HMAC进行校验
+type nameCipher struct {
+	block cipher.Block
+	mac   []byte
+}
+
+//文件名密钥只由密码与固定的盐派生,文件名的安全性只取决于密码的强度
+func newNameCipher(password string) (*nameCipher, error) {
+	material := pbkdf2.Key([]byte(password), []byte(nameSalt), nameCost, 64, sha256.New)
+	block, err := aes.NewCipher(material[:32])
+	if err != nil {
+		return nil, err
+	}
+	return &nameCipher{block, material[32:]}, nil
+}
+
+func (c *nameCipher) siv(name []byte) []byte {
+	h := hmac.New(sha256.New, c.mac)
+	h.Write(name)
+	return h.Sum(nil)[:aes.BlockSize]
+}
+
+//加密文件名
+func (c *nameCipher) seal(name []byte) []byte {
+	iv := c.siv(name)
+	data := make([]byte, aes.BlockSize+len(name))
+	copy(data, iv)
+	cipher.NewCTR(c.block, iv).XORKeyStream(data[aes.BlockSize:], name)
+	return data
+}
+
+//解密并校验文件名
+func (c *nameCipher) open(data []byte) ([]byte, error) {
+	if len(data) <= aes.BlockSize {
+		return nil, ErrorInvalidData
+	}
+	iv := data[:aes.BlockSize]
+	name := make([]byte, len(data)-aes.BlockSize)
+	cipher.NewCTR(c.block, iv).XORKeyStream(name, data[aes.BlockSize:])
+	if !hmac.Equal(iv, c.siv(name)) {
+		return nil, ErrorAES
+	}
+	return name, nil
+}
+
+//文件名或目录名加密后的文本形式
+func (c *nameCipher) Encode(name string) string {
+	return strings.ToLower(nameEncoding.EncodeToString(c.seal([]byte(name))))
+}
+
+func (c *nameCipher) Decode(name string) (string, error) {
+	data, err := nameEncoding.DecodeString(strings.ToUpper(name))
+	if err != nil {
+		return "", err
+	}
+	plain, err := c.open(data)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
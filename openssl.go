@@ -0,0 +1,215 @@
+package zzdm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"hash"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	//openssl enc加密文件的扩展名
+	OpenSSLExtension = ".enc"
+	//openssl enc -pbkdf2的默认迭代次数
+	OpenSSLCost  = 10000
+	opensslMagic = "Salted__"
+)
+
+//openssl格式使用的摘要算法,对应openssl enc的-md
+const (
+	DigestSHA256 = "sha256"
+	DigestSHA1   = "sha1"
+	DigestMD5    = "md5"
+)
+
+//openssl enc不带-pbkdf2时使用的EVP_BytesToKey
+const KDFEVP = "evp-bytestokey"
+
+//开头是否为openssl enc的加盐格式
+func isOpenSSL(prefix []byte) bool {
+	return bytes.HasPrefix(prefix, []byte(opensslMagic))
+}
+
+func digestFunc(digest string) (func() hash.Hash, error) {
+	switch digest {
+	case "", DigestSHA256:
+		return sha256.New, nil
+	case DigestSHA1:
+		return sha1.New, nil
+	case DigestMD5:
+		return md5.New, nil
+	}
+	return nil, ErrorKDF
+}
+
+//EVP_BytesToKey,迭代次数固定为1,与openssl enc一致
+func evpBytesToKey(password, salt []byte, h func() hash.Hash, length int) []byte {
+	key := make([]byte, 0, length+h().Size())
+	var last []byte
+	for len(key) < length {
+		d := h()
+		d.Write(last)
+		d.Write(password)
+		d.Write(salt)
+		last = d.Sum(nil)
+		key = append(key, last...)
+	}
+	return key[:length]
+}
+
+//由密码派生openssl格式的密钥与iv
+func (o *Options) opensslKey(password string, salt []byte) ([]byte, []byte, error) {
+	if len(password) == 0 {
+		return nil, nil, ErrorPassword
+	}
+	length, err := keyLength(o.Cipher)
+	if err != nil {
+		return nil, nil, err
+	}
+	h, err := digestFunc(o.Digest)
+	if err != nil {
+		return nil, nil, err
+	}
+	//默认的迭代次数是zzdm格式的,与openssl enc -pbkdf2不同
+	cost := o.KDFCost
+	if !o.kdfSet {
+		cost = OpenSSLCost
+	}
+	var material []byte
+	switch o.KDF {
+	case KDFEVP:
+		material = evpBytesToKey([]byte(password), salt, h, length+aes.BlockSize)
+	case "", KDFPBKDF2:
+		if cost <= 0 {
+			return nil, nil, ErrorKDF
+		}
+		material = pbkdf2.Key([]byte(password), salt, cost, length+aes.BlockSize, h)
+	default:
+		return nil, nil, ErrorKDF
+	}
+	return material[:length], material[length:], nil
+}
+
+//以openssl enc的Salted__格式加密数据流,末尾使用PKCS#7填充
+func (e *Encryptor) encryptOpenSSLStream(ctx context.Context, reader io.Reader, writer io.Writer, password string) error {
+	salt := make([]byte, 8)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return err
+	}
+	key, iv, err := e.options.opensslKey(password, salt)
+	if err != nil {
+		return err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	mode := cipher.NewCBCEncrypter(block, iv)
+	buffered := bufio.NewWriter(writer)
+	_, err = buffered.WriteString(opensslMagic)
+	if err != nil {
+		return err
+	}
+	_, err = buffered.Write(salt)
+	if err != nil {
+		return err
+	}
+	reader = &contextReader{ctx, reader}
+	buffer := make([]byte, BUFFER)
+	for {
+		n, err := io.ReadFull(reader, buffer)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			last := PKCS5Padding(buffer[:n], aes.BlockSize)
+			mode.CryptBlocks(last, last)
+			_, err = buffered.Write(last)
+			if err != nil {
+				return err
+			}
+			return buffered.Flush()
+		}
+		if err != nil {
+			return err
+		}
+		mode.CryptBlocks(buffer, buffer)
+		_, err = buffered.Write(buffer)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+//解密openssl enc格式的数据流,没有Salted__时按-nosalt处理
+//最后一块要读到末尾才能确定,因此始终留到最后校验填充后再写出
+func (d *Decryptor) decryptOpenSSLStream(ctx context.Context, reader io.Reader, writer io.Writer, password string) error {
+	buffered := bufio.NewReader(reader)
+	prefix, _ := buffered.Peek(len(opensslMagic) + 8)
+	var salt []byte
+	if isOpenSSL(prefix) {
+		if len(prefix) < len(opensslMagic)+8 {
+			return ErrorInvalidFile
+		}
+		salt = append(salt, prefix[len(opensslMagic):]...)
+		buffered.Discard(len(prefix))
+	}
+	key, iv, err := d.options.opensslKey(password, salt)
+	if err != nil {
+		return err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	mode := cipher.NewCBCDecrypter(block, iv)
+	reader = &contextReader{ctx, buffered}
+	buffer := make([]byte, BUFFER+aes.BlockSize)
+	held := 0
+	for {
+		n, err := io.ReadFull(reader, buffer[held:])
+		held += n
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			if held == 0 || held%aes.BlockSize != 0 {
+				return ErrorAES
+			}
+			mode.CryptBlocks(buffer[:held], buffer[:held])
+			padding := int(buffer[held-1])
+			if padding == 0 || padding > aes.BlockSize || !bytes.Equal(buffer[held-padding:held], bytes.Repeat([]byte{byte(padding)}, padding)) {
+				return ErrorAES
+			}
+			_, err = writer.Write(buffer[:held-padding])
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		//保留最后一块,其余的解密后写出
+		size := held - aes.BlockSize
+		mode.CryptBlocks(buffer[:size], buffer[:size])
+		_, err = writer.Write(buffer[:size])
+		if err != nil {
+			return err
+		}
+		copy(buffer, buffer[size:held])
+		held = aes.BlockSize
+	}
+}
+
+//以openssl格式加密文件,输出文件名为原文件名加上.enc
+func (e *Encryptor) encryptOpenSSL(ctx context.Context, input, output, password string) error {
+	return e.encryptFormat(ctx, input, output, password, OpenSSLExtension, e.encryptOpenSSLStream)
+}
+
+//解密openssl格式的文件,输出文件名为去掉.enc的原文件名
+func (d *Decryptor) decryptOpenSSL(ctx context.Context, file *os.File, input, output, password string) error {
+	return d.decryptFormat(ctx, file, input, output, password, OpenSSLExtension, d.decryptOpenSSLStream)
+}
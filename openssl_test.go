@@ -0,0 +1,38 @@
+package zzdm
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+)
+
+//testdata/openssl中的文件由openssl enc -pbkdf2 -md sha256加密,迭代次数为默认的10000
+func TestDecryptOpenSSL(t *testing.T) {
+	expected, _ := ioutil.ReadFile("testdata/v1/multi.txt")
+	cases := []struct {
+		input   string
+		options []Option
+		ok      bool
+	}{
+		{"testdata/openssl/multi-aes256.enc", nil, true},
+		{"testdata/openssl/multi-aes128.enc", []Option{WithCipher(CipherAES128CBC)}, true},
+		{"testdata/openssl/multi-aes256.enc", []Option{WithKDF(KDFPBKDF2, OpenSSLCost)}, true},
+		{"testdata/openssl/multi-aes256.enc", []Option{WithKDF(KDFPBKDF2, DefaultKDFCost)}, false},
+		{"testdata/openssl/multi-aes128.enc", nil, false},
+	}
+	for _, c := range cases {
+		data, err := ioutil.ReadFile(c.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var output bytes.Buffer
+		err = NewDecryptor(c.options...).DecryptStream(context.Background(), bytes.NewReader(data), &output, "password")
+		if c.ok && (err != nil || !bytes.Equal(output.Bytes(), expected)) {
+			t.Errorf("%s %d: %v", c.input, len(c.options), err)
+		}
+		if !c.ok && err == nil && bytes.Equal(output.Bytes(), expected) {
+			t.Errorf("%s %d: decrypted with the wrong settings", c.input, len(c.options))
+		}
+	}
+}
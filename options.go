@@ -0,0 +1,365 @@
+package zzdm
+
+import (
+	"context"
+	"crypto/ed25519"
+	"io/ioutil"
+	"log"
+	"runtime"
+)
+
+//加密套件
+const (
+	CipherAES128CBC = "aes-128-cbc"
+	CipherAES192CBC = "aes-192-cbc"
+	CipherAES256CBC = "aes-256-cbc"
+)
+
+//密钥派生算法
+const (
+	//密码截断或补零后直接作为密钥,兼容旧版本
+	KDFNone = "none"
+	//PBKDF2-HMAC-SHA256,使用随机盐
+	KDFPBKDF2 = "pbkdf2-sha256"
+)
+
+//输出文件命名策略
+const (
+	//沿用原文件名
+	NamingOriginal = iota
+	//以密码确定性地加密文件名,相同的密码与文件名总是得到相同的输出文件名
+	//文件名密钥使用固定的盐派生,弱密码加密的文件名可以被预计算的字典破解
+	NamingSecret
+)
+
+//输出文件已存在时的处理策略
+const (
+	//返回ErrorFileDuplicated
+	OverwriteNever = iota
+	//清空后覆盖
+	OverwriteForce
+	//跳过这个文件
+	OverwriteSkip
+	//在文件名后加上" (1)"、" (2)"等序号
+	OverwriteRename
+	//输入文件比输出文件新时覆盖,否则跳过
+	OverwriteNewer
+)
+
+//输出文件已存在时的处理策略的名称
+var conflictNames = map[string]int{
+	"error":     OverwriteNever,
+	"overwrite": OverwriteForce,
+	"skip":      OverwriteSkip,
+	"rename":    OverwriteRename,
+	"newer":     OverwriteNewer,
+}
+
+//由名称得到输出文件已存在时的处理策略:error、overwrite、skip、rename或newer
+func ParseConflict(name string) (int, error) {
+	overwrite, ok := conflictNames[name]
+	if !ok {
+		return 0, ErrorConflict
+	}
+	return overwrite, nil
+}
+
+const (
+	//PBKDF2默认迭代次数
+	DefaultKDFCost = 100000
+)
+
+//加解密进度
+type Progress struct {
+	//已处理的帧数
+	Index int64
+	//总帧数
+	Frames int64
+	//当前帧的明文字节数
+	Bytes int
+}
+
+//进度回调
+type ProgressFunc func(progress Progress)
+
+//加解密配置
+type Options struct {
+	//加密套件
+	Cipher string
+	//密钥派生算法
+	KDF string
+	//密钥派生的迭代次数
+	KDFCost int
+	//是否用WithKDF指定了密钥派生,openssl格式没有指定时使用OpenSSLCost
+	kdfSet bool
+	//每帧的明文字节数
+	FrameSize int64
+	//长度填充策略
+	Padding string
+	//PaddingBucket策略的填充单位
+	PaddingBucket int64
+	//输出文件命名策略
+	Naming int
+	//输出文件格式,FormatZZDM、FormatAge或FormatOpenSSL,解密时自动识别
+	Format string
+	//openssl格式密钥派生使用的摘要算法
+	Digest string
+	//age格式的X25519公钥,为空时使用口令
+	Recipients []string
+	//解密age格式时使用的身份文件
+	Identities []string
+	//输出PEM格式的文本,解密时自动识别
+	Armor bool
+	//输出文件已存在时的处理策略
+	Overwrite int
+	//不为空时输出到这个文件而不是输出目录,只能用于单个文件
+	OutputFile string
+	//输出文件已存在时从最后一个完整的帧之后继续,取消时保留未完成的输出
+	Resume bool
+	//加密时记录、解密时恢复权限、时间、所有者与扩展属性
+	Preserve bool
+	//加密后解密输出文件确认与源文件一致,再覆盖ShredPasses遍随机数据后删除源文件
+	RemoveSource bool
+	ShredPasses  int
+	//进度回调,为nil时不输出进度
+	Progress ProgressFunc
+	//大于0时随机生成主密钥并拆分为Shares份,任意Threshold份可以解密,份额写入输出文件旁
+	Shares    int
+	Threshold int
+	//解密时使用的份额文件
+	ShareFiles []string
+	//加密时用于签名的私钥,为nil时不签名
+	SigningKey ed25519.PrivateKey
+	//解密时受信任的签名公钥,不为空时文件必须由其中之一签名
+	TrustedKeys []Signer
+	//签名校验通过时的回调
+	Signed SignerFunc
+	//每个文件处理完成时的回调,为nil时不统计
+	Result ResultFunc
+	//每组数据帧的数量,大于0时在每组之后写入校验帧
+	ParityData int
+	//每组校验帧的数量,每组最多可以修复同样数量的损坏帧
+	ParityShards int
+	//并发处理的帧数
+	Concurrency int
+	//日志,为nil时不输出日志
+	Logger *log.Logger
+}
+
+//配置项
+type Option func(options *Options)
+
+//默认配置
+func DefaultOptions() Options {
+	return Options{
+		Cipher:      CipherAES256CBC,
+		KDF:         KDFPBKDF2,
+		KDFCost:     DefaultKDFCost,
+		FrameSize:   BUFFER,
+		Padding:     PaddingNone,
+		Format:      FormatZZDM,
+		Digest:      DigestSHA256,
+		Naming:      NamingOriginal,
+		Overwrite:   OverwriteNever,
+		Preserve:    true,
+		Concurrency: runtime.NumCPU(),
+	}
+}
+
+//使用完整的配置
+func WithOptions(options Options) Option {
+	return func(o *Options) {
+		*o = options
+	}
+}
+
+func WithCipher(cipher string) Option {
+	return func(o *Options) {
+		o.Cipher = cipher
+	}
+}
+
+func WithKDF(kdf string, cost int) Option {
+	return func(o *Options) {
+		o.KDF = kdf
+		o.KDFCost = cost
+		o.kdfSet = true
+	}
+}
+
+func WithDigest(digest string) Option {
+	return func(o *Options) {
+		o.Digest = digest
+	}
+}
+
+func WithFrameSize(size int64) Option {
+	return func(o *Options) {
+		o.FrameSize = size
+	}
+}
+
+//bucket仅用于PaddingBucket策略
+func WithPadding(policy string, bucket int64) Option {
+	return func(o *Options) {
+		o.Padding = policy
+		o.PaddingBucket = bucket
+	}
+}
+
+func WithNaming(naming int) Option {
+	return func(o *Options) {
+		o.Naming = naming
+	}
+}
+
+func WithOverwrite(overwrite int) Option {
+	return func(o *Options) {
+		o.Overwrite = overwrite
+	}
+}
+
+//输出到指定的文件,递归与批量处理时返回ErrorOutputFile
+func WithOutputFile(path string) Option {
+	return func(o *Options) {
+		o.OutputFile = path
+	}
+}
+
+func WithFormat(format string) Option {
+	return func(o *Options) {
+		o.Format = format
+	}
+}
+
+//age格式的接收者公钥
+func WithRecipients(recipients ...string) Option {
+	return func(o *Options) {
+		o.Recipients = recipients
+	}
+}
+
+//age格式的身份文件
+func WithIdentities(identities ...string) Option {
+	return func(o *Options) {
+		o.Identities = identities
+	}
+}
+
+func WithArmor(armor bool) Option {
+	return func(o *Options) {
+		o.Armor = armor
+	}
+}
+
+func WithResume(resume bool) Option {
+	return func(o *Options) {
+		o.Resume = resume
+	}
+}
+
+func WithPreserve(preserve bool) Option {
+	return func(o *Options) {
+		o.Preserve = preserve
+	}
+}
+
+//加密成功后粉碎源文件,passes为覆盖随机数据的次数,为0时只改名删除
+func WithRemoveSource(passes int) Option {
+	return func(o *Options) {
+		o.RemoveSource = true
+		o.ShredPasses = passes
+	}
+}
+
+func WithProgress(progress ProgressFunc) Option {
+	return func(o *Options) {
+		o.Progress = progress
+	}
+}
+
+//每data个数据帧之后写入shards个校验帧,data为0时不写入校验帧
+func WithParity(data, shards int) Option {
+	return func(o *Options) {
+		o.ParityData = data
+		o.ParityShards = shards
+	}
+}
+
+func WithConcurrency(concurrency int) Option {
+	return func(o *Options) {
+		o.Concurrency = concurrency
+	}
+}
+
+func WithLogger(logger *log.Logger) Option {
+	return func(o *Options) {
+		o.Logger = logger
+	}
+}
+
+func newOptions(options []Option) Options {
+	o := DefaultOptions()
+	for _, option := range options {
+		option(&o)
+	}
+	if o.FrameSize <= 0 {
+		o.FrameSize = BUFFER
+	}
+	if o.ParityData > 0 && o.ParityShards <= 0 {
+		o.ParityShards = DefaultParityShards
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 1
+	}
+	if o.Logger == nil {
+		o.Logger = log.New(ioutil.Discard, "", 0)
+	}
+	return o
+}
+
+func (o *Options) progress(ctx context.Context, index, frames int64, bytes int) {
+	if result := contextResult(ctx); result != nil {
+		result.Frames = index
+	}
+	if o.Progress != nil {
+		o.Progress(Progress{index, frames, bytes})
+	}
+}
+
+func WithSigningKey(key ed25519.PrivateKey) Option {
+	return func(o *Options) {
+		o.SigningKey = key
+	}
+}
+
+func WithTrustedKeys(keys ...Signer) Option {
+	return func(o *Options) {
+		o.TrustedKeys = keys
+	}
+}
+
+func WithSigned(signed SignerFunc) Option {
+	return func(o *Options) {
+		o.Signed = signed
+	}
+}
+
+func WithResult(result ResultFunc) Option {
+	return func(o *Options) {
+		o.Result = result
+	}
+}
+
+func WithShares(shares, threshold int) Option {
+	return func(o *Options) {
+		o.Shares = shares
+		o.Threshold = threshold
+	}
+}
+
+func WithShareFiles(paths ...string) Option {
+	return func(o *Options) {
+		o.ShareFiles = paths
+	}
+}
@@ -0,0 +1,110 @@
+package zzdm
+
+import (
+	"io"
+	"math/bits"
+)
+
+//长度填充策略
+const (
+	//不填充
+	PaddingNone = "none"
+	//填充到2的幂
+	PaddingPow2 = "pow2"
+	//Padmé,填充开销不超过约12%
+	PaddingPadme = "padme"
+	//填充到固定大小的整数倍
+	PaddingBucket = "bucket"
+)
+
+//按策略计算填充后的长度
+func paddedLength(size int64, policy string, bucket int64) (int64, error) {
+	if size <= 0 {
+		return size, nil
+	}
+	switch policy {
+	case "", PaddingNone:
+		return size, nil
+	case PaddingPow2:
+		if size&(size-1) == 0 {
+			return size, nil
+		}
+		return 1 << uint(bits.Len64(uint64(size))), nil
+	case PaddingPadme:
+		if size < 2 {
+			return size, nil
+		}
+		e := bits.Len64(uint64(size)) - 1 //floor(log2(size))
+		s := bits.Len64(uint64(e))        //floor(log2(e))+1
+		mask := int64(1)<<uint(e-s) - 1
+		return (size + mask) &^ mask, nil
+	case PaddingBucket:
+		if bucket <= 0 {
+			return 0, ErrorPadding
+		}
+		left := size % bucket
+		if left == 0 {
+			return size, nil
+		}
+		return size + bucket - left, nil
+	}
+	return 0, ErrorPadding
+}
+
+//产生固定数量的0作为填充
+type zeroReader struct {
+	left int64
+}
+
+func (r *zeroReader) Read(p []byte) (int, error) {
+	if r.left <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > r.left {
+		p = p[:r.left]
+	}
+	for i := range p {
+		p[i] = 0
+	}
+	r.left -= int64(len(p))
+	return len(p), nil
+}
+
+//统计读取的字节数
+type countReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countReader) Read(p []byte) (int, error) {
+	num, err := r.reader.Read(p)
+	r.count += int64(num)
+	return num, err
+}
+
+//只写入前size个字节,其余部分必须是填充的0
+type paddingWriter struct {
+	writer io.Writer
+	left   int64
+}
+
+func (w *paddingWriter) Write(p []byte) (int, error) {
+	data := p
+	if int64(len(data)) > w.left {
+		data = data[:w.left]
+		for _, b := range p[w.left:] {
+			if b != 0 {
+				return 0, ErrorPadding
+			}
+		}
+	}
+	num, err := w.writer.Write(data)
+	w.left -= int64(num)
+	if err != nil {
+		return num, err
+	}
+	if num != len(data) {
+		return num, ErrorDataMissing
+	}
+	return len(p), nil
+}
@@ -0,0 +1,68 @@
+package zzdm
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestPaddedLength(t *testing.T) {
+	cases := []struct {
+		policy string
+		bucket int64
+		size   int64
+		padded int64
+	}{
+		{PaddingNone, 0, 1000, 1000},
+		{PaddingPow2, 0, 0, 0},
+		{PaddingPow2, 0, 1, 1},
+		{PaddingPow2, 0, 1000, 1024},
+		{PaddingPow2, 0, 1024, 1024},
+		{PaddingPow2, 0, 100000, 131072},
+		{PaddingPadme, 0, 9, 10},
+		{PaddingPadme, 0, 1000, 1024},
+		{PaddingPadme, 0, 100000, 100352},
+		{PaddingBucket, 3000, 1, 3000},
+		{PaddingBucket, 3000, 3000, 3000},
+		{PaddingBucket, 3000, 3001, 6000},
+	}
+	for _, c := range cases {
+		padded, err := paddedLength(c.size, c.policy, c.bucket)
+		if err != nil || padded != c.padded {
+			t.Errorf("%s(%d): got %d %v, want %d", c.policy, c.size, padded, err, c.padded)
+		}
+	}
+	for _, c := range []struct {
+		policy string
+		bucket int64
+	}{{"zero", 0}, {PaddingBucket, 0}} {
+		if _, err := paddedLength(100, c.policy, c.bucket); !errors.Is(err, ErrorPadding) {
+			t.Errorf("%s/%d: got %v", c.policy, c.bucket, err)
+		}
+	}
+	//Padmé的开销不超过约12%
+	for size := int64(2); size < 1<<20; size = size*3/2 + 1 {
+		padded, _ := paddedLength(size, PaddingPadme, 0)
+		if padded < size || float64(padded-size) > float64(size)*0.12 {
+			t.Fatalf("padme(%d) = %d", size, padded)
+		}
+	}
+}
+
+func TestPaddingRoundTrip(t *testing.T) {
+	for _, policy := range []string{PaddingNone, PaddingPow2, PaddingPadme, PaddingBucket} {
+		var lengths []int
+		for _, size := range []int{0, 1, 2000, 2999, 4097} {
+			plain := testPlain(size)
+			encrypted := encryptBytes(t, plain, WithPadding(policy, 3000), WithFrameSize(1024))
+			if got := decryptBytes(t, encrypted); !bytes.Equal(got, plain) {
+				t.Fatalf("%s(%d): plaintext mismatch", policy, size)
+			}
+			lengths = append(lengths, len(encrypted))
+		}
+		//同一个桶中的明文得到长度几乎相同的密文,只有元数据与记录中变长整数的长度不同
+		if policy == PaddingBucket && (lengths[3]-lengths[1] > 16 || lengths[4]-lengths[3] < 3000) {
+			t.Fatalf("bucket lengths %v", lengths)
+		}
+	}
+}
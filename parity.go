@@ -0,0 +1,358 @@
+package zzdm
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/reedsolomon"
+)
+
+const (
+	//每组校验帧数量的默认值
+	DefaultParityShards = 2
+	//每组数据帧与校验帧的总数上限
+	maxParityShards = 256
+)
+
+//纠删码分组:每组数据帧的记录补0到组内最大长度后以Reed-Solomon编码得到校验帧
+//记录本身带有长度前缀,重建后可以去掉补上的0
+type parityGroup struct {
+	keys    *fileKeys
+	shards  int
+	index   int64
+	records [][]byte
+}
+
+func (g *parityGroup) add(record []byte) {
+	g.records = append(g.records, record)
+}
+
+//写入当前组的校验帧并开始下一组
+func (g *parityGroup) flush(writer io.Writer) error {
+	if len(g.records) == 0 {
+		return nil
+	}
+	parity, err := encodeParity(g.records, g.shards)
+	if err != nil {
+		return err
+	}
+	for shard, data := range parity {
+		err = writeFrame(writer, &Frame{Parity: data, Mac: g.keys.parityMac(g.index, int64(shard), data)})
+		if err != nil {
+			return err
+		}
+	}
+	g.index++
+	g.records = g.records[:0]
+	return nil
+}
+
+func checkParity(data, shards int) error {
+	if data < 0 || (data > 0 && (shards <= 0 || data+shards > maxParityShards)) {
+		return ErrorParity
+	}
+	return nil
+}
+
+//计算一组记录的校验块
+func encodeParity(records [][]byte, shards int) ([][]byte, error) {
+	size := 0
+	for _, record := range records {
+		if len(record) > size {
+			size = len(record)
+		}
+	}
+	encoder, err := reedsolomon.New(len(records), shards)
+	if err != nil {
+		return nil, err
+	}
+	all := make([][]byte, len(records)+shards)
+	for i := range all {
+		all[i] = make([]byte, size)
+		if i < len(records) {
+			copy(all[i], records[i])
+		}
+	}
+	err = encoder.Encode(all)
+	if err != nil {
+		return nil, err
+	}
+	return all[len(records):], nil
+}
+
+//按组收集通过认证的帧,缺失的帧由校验帧重建后重新写出
+type repairer struct {
+	header   *Header
+	keys     *fileKeys
+	writer   io.Writer
+	data     int64
+	shards   int
+	groups   int64
+	group    int64
+	records  [][]byte
+	parity   [][]byte
+	repaired int
+	damaged  bool
+}
+
+func newRepairer(header *Header, keys *fileKeys, writer io.Writer) *repairer {
+	r := &repairer{
+		header: header,
+		keys:   keys,
+		writer: writer,
+		data:   int64(header.ParityData),
+		shards: int(header.ParityShards),
+	}
+	r.groups = (header.Frames + r.data - 1) / r.data
+	r.reset()
+	return r
+}
+
+func (r *repairer) reset() {
+	r.records = make([][]byte, r.groupSize(r.group))
+	r.parity = make([][]byte, r.shards)
+}
+
+//组内的数据帧数量,最后一组可能不满
+func (r *repairer) groupSize(group int64) int {
+	size := r.header.Frames - group*r.data
+	if size > r.data {
+		size = r.data
+	}
+	if size < 0 {
+		size = 0
+	}
+	return int(size)
+}
+
+//按认证码确定帧的位置,只在当前组与下一组中查找
+func (r *repairer) add(frame *Frame) error {
+	last := r.group + 2
+	if last > r.groups {
+		last = r.groups
+	}
+	if len(frame.Parity) > 0 {
+		for group := r.group; group < last; group++ {
+			for shard := 0; shard < r.shards; shard++ {
+				if !hmac.Equal(frame.Mac, r.keys.parityMac(group, int64(shard), frame.Parity)) {
+					continue
+				}
+				err := r.advance(group)
+				if err != nil {
+					return err
+				}
+				r.parity[shard] = frame.Parity
+				return nil
+			}
+		}
+		r.damaged = true
+		return nil
+	}
+	for index := r.group * r.data; index < last*r.data && index < r.header.Frames; index++ {
+		if !hmac.Equal(frame.Mac, r.keys.frameMac(index, frame.Iv, frame.Data)) {
+			continue
+		}
+		err := r.advance(index / r.data)
+		if err != nil {
+			return err
+		}
+		record, err := frameRecord(frame)
+		if err != nil {
+			return err
+		}
+		r.records[index%r.data] = record
+		return nil
+	}
+	r.damaged = true
+	return nil
+}
+
+func (r *repairer) advance(group int64) error {
+	for r.group < group {
+		err := r.flush()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//重建当前组缺失的数据帧,写出数据帧与重新计算的校验帧
+func (r *repairer) flush() error {
+	missing := make([]int, 0)
+	shards := make([][]byte, len(r.records)+r.shards)
+	size := 0
+	for i, record := range r.records {
+		if record == nil {
+			missing = append(missing, i)
+		}
+		shards[i] = record
+	}
+	lost := 0
+	for i, parity := range r.parity {
+		if parity == nil {
+			lost++
+			continue
+		}
+		shards[len(r.records)+i] = parity
+		size = len(parity)
+	}
+	if len(missing) > 0 || lost > 0 {
+		r.damaged = true
+	}
+	if len(missing) > 0 {
+		if len(missing)+lost > r.shards {
+			return ErrorRepair
+		}
+		for i, record := range r.records {
+			if record == nil {
+				continue
+			}
+			if len(record) > size {
+				return ErrorRepair
+			}
+			shard := make([]byte, size)
+			copy(shard, record)
+			shards[i] = shard
+		}
+		decoder, err := reedsolomon.New(len(r.records), r.shards)
+		if err != nil {
+			return err
+		}
+		err = decoder.ReconstructData(shards)
+		if err != nil {
+			return ErrorRepair
+		}
+		for _, i := range missing {
+			shard := shards[i]
+			length := binary.BigEndian.Uint64(shard)
+			if length == 0 || length > uint64(size-8) {
+				return ErrorRepair
+			}
+			frame := &Frame{}
+			err = frame.Unmarshal(shard[8 : 8+length])
+			if err != nil {
+				return ErrorRepair
+			}
+			index := r.group*r.data + int64(i)
+			if !hmac.Equal(frame.Mac, r.keys.frameMac(index, frame.Iv, frame.Data)) {
+				return ErrorRepair
+			}
+			r.records[i] = shard[:8+length]
+			r.repaired++
+		}
+	}
+	group := &parityGroup{keys: r.keys, shards: r.shards, index: r.group}
+	for _, record := range r.records {
+		_, err := r.writer.Write(record)
+		if err != nil {
+			return err
+		}
+		group.add(record)
+	}
+	err := group.flush(r.writer)
+	if err != nil {
+		return err
+	}
+	r.group++
+	r.reset()
+	return nil
+}
+
+//利用校验帧修复损坏或丢失的数据帧,修复后的文件替换原文件,返回重建的数据帧数量
+//文件头无法修复,校验帧也会在写出时重新计算
+func (d *Decryptor) Repair(ctx context.Context, input, password string) (int, error) {
+	file, err := os.Open(input)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	header, err := ReadHead(file)
+	if err != nil {
+		return 0, err
+	}
+	if header == nil {
+		return 0, ErrorFileIO
+	}
+	if header.ParityData <= 0 {
+		return 0, ErrorNoParity
+	}
+	err = checkParity(int(header.ParityData), int(header.ParityShards))
+	if err != nil {
+		return 0, err
+	}
+	keys, err := d.options.headerKeys(header, password)
+	if err != nil {
+		return 0, err
+	}
+	if keys.mac == nil {
+		return 0, ErrorNoParity
+	}
+	temp, err := ioutil.TempFile(filepath.Dir(input), ".zzdm-")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(temp.Name())
+	defer temp.Close()
+	writer := bufio.NewWriter(temp)
+	err = writeHeader(writer, header)
+	if err != nil {
+		return 0, err
+	}
+	scanner := newFrameScanner(file, header)
+	r := newRepairer(header, keys, writer)
+	//签名不包含校验帧,修复后原样保留
+	var trailer *Frame
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		frame, err := scanner.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if len(frame.Signature) > 0 {
+			trailer = frame
+			continue
+		}
+		err = r.add(frame)
+		if err != nil {
+			return r.repaired, err
+		}
+	}
+	err = r.advance(r.groups)
+	if err != nil {
+		return r.repaired, err
+	}
+	if !r.damaged && scanner.skipped == 0 {
+		return 0, nil
+	}
+	if trailer != nil {
+		err = writeFrame(writer, trailer)
+		if err != nil {
+			return r.repaired, err
+		}
+	}
+	err = writer.Flush()
+	if err != nil {
+		return r.repaired, err
+	}
+	err = temp.Close()
+	if err != nil {
+		return r.repaired, err
+	}
+	if info, err := os.Stat(input); err == nil {
+		os.Chmod(temp.Name(), info.Mode())
+	}
+	d.options.Logger.Printf("repair %s: %d frames rebuilt, %d bytes skipped", input, r.repaired, scanner.skipped)
+	return r.repaired, os.Rename(temp.Name(), input)
+}
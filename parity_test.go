@@ -0,0 +1,80 @@
+package zzdm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestRepair(t *testing.T) {
+	plain := testPlain(10000)
+	//10帧分为4、4、2三组,每组2个校验帧
+	encrypted := encryptBytes(t, plain, WithFrameSize(1024), WithParity(4, 2))
+	cases := []struct {
+		name     string
+		change   func(frames []*Frame) []*Frame
+		repaired int
+		err      error
+	}{
+		{"undamaged", func(frames []*Frame) []*Frame {
+			return frames
+		}, 0, nil},
+		{"two frames lost", func(frames []*Frame) []*Frame {
+			return append(frames[:1:1], frames[3:]...)
+		}, 2, nil},
+		{"corrupted frames", func(frames []*Frame) []*Frame {
+			frames[6].Data[0] ^= 1
+			frames[13].Iv[0] ^= 1
+			return frames
+		}, 2, nil},
+		{"last frame lost", func(frames []*Frame) []*Frame {
+			return append(frames[:13:13], frames[14:]...)
+		}, 1, nil},
+		{"data and parity frame lost", func(frames []*Frame) []*Frame {
+			frames[4].Parity[0] ^= 1
+			return append(frames[:0:0], frames[1:]...)
+		}, 1, nil},
+		{"parity frames only", func(frames []*Frame) []*Frame {
+			frames[10].Parity[0] ^= 1
+			frames[11].Parity[0] ^= 1
+			return frames
+		}, 0, nil},
+		{"too many frames lost", func(frames []*Frame) []*Frame {
+			return append(frames[:6:6], frames[9:]...)
+		}, 0, ErrorRepair},
+	}
+	dir := t.TempDir()
+	for _, c := range cases {
+		header, frames := readRecords(t, encrypted)
+		var buffer bytes.Buffer
+		writeRecords(t, &buffer, header, c.change(frames))
+		path := filepath.Join(dir, "plain.scc")
+		ioutil.WriteFile(path, buffer.Bytes(), 0644)
+		repaired, err := NewDecryptor().Repair(context.Background(), path, "password")
+		if !errors.Is(err, c.err) || repaired != c.repaired {
+			t.Errorf("%s: got %d %v, want %d %v", c.name, repaired, err, c.repaired, c.err)
+			continue
+		}
+		if err != nil {
+			//无法修复时不改动原文件
+			assertContent(t, path, buffer.Bytes())
+			continue
+		}
+		data, _ := ioutil.ReadFile(path)
+		if got := decryptBytes(t, data); !bytes.Equal(got, plain) {
+			t.Errorf("%s: plaintext mismatch", c.name)
+		}
+		if !bytes.Equal(data, encrypted) {
+			t.Errorf("%s: repaired file differs from the original", c.name)
+		}
+	}
+	//没有校验帧的文件不能修复
+	path := filepath.Join(dir, "plain.scc")
+	ioutil.WriteFile(path, encryptBytes(t, plain), 0644)
+	if _, err := NewDecryptor().Repair(context.Background(), path, "password"); !errors.Is(err, ErrorNoParity) {
+		t.Errorf("no parity: %v", err)
+	}
+}
@@ -0,0 +1,236 @@
+package zzdm
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/BurntSushi/toml"
+)
+
+//口令必须包含的字符类别
+const (
+	ClassUpper  = "upper"
+	ClassLower  = "lower"
+	ClassDigit  = "digit"
+	ClassSymbol = "symbol"
+)
+
+//可以写作天数的时间长度,如90d,也可以是time.ParseDuration支持的格式
+type Duration time.Duration
+
+func ParseDuration(text string) (Duration, error) {
+	text = strings.TrimSpace(text)
+	if len(text) == 0 {
+		return 0, nil
+	}
+	if strings.HasSuffix(text, "d") {
+		days, err := strconv.ParseFloat(strings.TrimSuffix(text, "d"), 64)
+		if err != nil || days < 0 {
+			return 0, ErrorDuration
+		}
+		return Duration(days * float64(24*time.Hour)), nil
+	}
+	d, err := time.ParseDuration(text)
+	if err != nil || d < 0 {
+		return 0, ErrorDuration
+	}
+	return Duration(d), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+//整天数写作天数
+func (d Duration) String() string {
+	day := Duration(24 * time.Hour)
+	if d > 0 && d%day == 0 {
+		return fmt.Sprintf("%dd", d/day)
+	}
+	return time.Duration(d).String()
+}
+
+//口令策略,为0或为空的项不检查
+type Policy struct {
+	MinLength int `toml:"min_length"`
+	//估计的最低熵,单位为比特,即估计猜测次数以2为底的对数
+	MinEntropy float64 `toml:"min_entropy"`
+	//必须包含的字符类别
+	Classes []string `toml:"classes"`
+	//不能包含的词,不区分大小写,常见的字符替换也会还原后检查
+	BannedWords []string `toml:"banned_words"`
+	//口令的最长使用期限,由加密文件头中的时间判断
+	MaxAge Duration `toml:"max_age"`
+}
+
+//读取TOML格式的策略文件,不认识的项视为错误
+func LoadPolicy(path string) (*Policy, error) {
+	policy := &Policy{}
+	meta, err := toml.DecodeFile(path, policy)
+	if err != nil {
+		return nil, err
+	}
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		return nil, fmt.Errorf("%s: unknown policy key %s", path, undecoded[0])
+	}
+	err = policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+//检查策略本身是否有效
+func (p *Policy) Validate() error {
+	for _, class := range p.Classes {
+		if classChecker(class) == nil {
+			return fmt.Errorf("%w: %s", ErrorPolicyClass, class)
+		}
+	}
+	if p.MinLength < 0 || p.MinEntropy < 0 || p.MaxAge < 0 {
+		return ErrorPolicyLimit
+	}
+	return nil
+}
+
+func classChecker(class string) func(rune) bool {
+	switch class {
+	case ClassUpper:
+		return unicode.IsUpper
+	case ClassLower:
+		return unicode.IsLower
+	case ClassDigit:
+		return unicode.IsDigit
+	case ClassSymbol:
+		return func(c rune) bool {
+			return unicode.IsPunct(c) || unicode.IsSymbol(c) || unicode.IsSpace(c)
+		}
+	}
+	return nil
+}
+
+var classNames = map[string]string{
+	ClassUpper:  "an uppercase letter",
+	ClassLower:  "a lowercase letter",
+	ClassDigit:  "a digit",
+	ClassSymbol: "a symbol",
+}
+
+//口令违反的全部规则,userInputs与EstimatePassword相同
+func (p *Policy) Violations(password string, userInputs ...string) []string {
+	var violations []string
+	runes := []rune(password)
+	if len(runes) < p.MinLength {
+		violations = append(violations, fmt.Sprintf("shorter than %d characters", p.MinLength))
+	}
+	if p.MinEntropy > 0 {
+		entropy := math.Log2(EstimatePassword(password, userInputs...).Guesses)
+		if entropy < p.MinEntropy {
+			violations = append(violations, fmt.Sprintf("estimated entropy is %.0f bits, at least %g bits are required", entropy, p.MinEntropy))
+		}
+	}
+	for _, class := range p.Classes {
+		checker := classChecker(class)
+		if checker == nil {
+			violations = append(violations, fmt.Sprintf("unknown character class %q", class))
+			continue
+		}
+		found := false
+		for _, c := range runes {
+			if checker(c) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			violations = append(violations, fmt.Sprintf("does not contain %s", classNames[class]))
+		}
+	}
+	if len(p.BannedWords) > 0 {
+		//原样以及还原常见的字符替换之后分别检查
+		variants := []string{string(lowerRunes(runes))}
+		for _, sub := range l33tSubs(runes) {
+			if len(sub) == 0 {
+				continue
+			}
+			subbed := make([]rune, len(runes))
+			for i, c := range lowerRunes(runes) {
+				subbed[i] = c
+				if letter, ok := sub[c]; ok {
+					subbed[i] = letter
+				}
+			}
+			variants = append(variants, string(subbed))
+		}
+		for _, word := range p.BannedWords {
+			lower := strings.ToLower(strings.TrimSpace(word))
+			if len(lower) == 0 {
+				continue
+			}
+			for _, variant := range variants {
+				if strings.Contains(variant, lower) {
+					violations = append(violations, fmt.Sprintf("contains the banned word %q", word))
+					break
+				}
+			}
+		}
+	}
+	return violations
+}
+
+//检查口令是否符合策略,不符合时错误中列出全部问题
+func (p *Policy) Check(password string, userInputs ...string) error {
+	violations := p.Violations(password, userInputs...)
+	if len(violations) > 0 {
+		return fmt.Errorf("%w: %s", ErrorPolicy, strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+//检查加密文件的口令是否超过使用期限,没有记录时间的文件不检查
+func (p *Policy) CheckAge(header *Header) error {
+	if p.MaxAge <= 0 || header == nil || header.KeyTime <= 0 {
+		return nil
+	}
+	age := time.Since(time.Unix(header.KeyTime, 0))
+	if age <= time.Duration(p.MaxAge) {
+		return nil
+	}
+	if age >= 24*time.Hour {
+		age = age.Round(24 * time.Hour)
+	} else {
+		age = age.Round(time.Second)
+	}
+	return fmt.Errorf("%w: set %s ago, the limit is %s", ErrorPasswordAge, Duration(age), p.MaxAge)
+}
+
+//读取加密文件的文件头检查口令的使用期限,不是zzdm二进制格式的文件不检查
+func (p *Policy) CheckFile(path string) error {
+	if p.MaxAge <= 0 {
+		return nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	header, err := ReadHead(file)
+	if err != nil {
+		return nil
+	}
+	return p.CheckAge(header)
+}
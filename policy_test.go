@@ -0,0 +1,101 @@
+package zzdm
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPolicyViolations(t *testing.T) {
+	policy := &Policy{
+		MinLength:   10,
+		MinEntropy:  30,
+		Classes:     []string{ClassUpper, ClassDigit},
+		BannedWords: []string{"zzdm", " Secret "},
+	}
+	cases := []struct {
+		password   string
+		violations int
+	}{
+		{"Kettle-Drum-Violet-7", 0},
+		{"Kettle-Drum-Violet", 1},
+		{"password", 4},
+		{"Kettle-zzdm-Violet-7", 1},
+		{"Kettle-5ecr3t-Violet-7", 1},
+		{"Kettle-SECRET-Violet-7", 1},
+	}
+	for _, c := range cases {
+		violations := policy.Violations(c.password)
+		if len(violations) != c.violations {
+			t.Errorf("%s: %v", c.password, violations)
+		}
+		err := policy.Check(c.password)
+		if (err != nil) != (c.violations > 0) || (err != nil && !errors.Is(err, ErrorPolicy)) {
+			t.Errorf("%s: check %v", c.password, err)
+		}
+	}
+	//用户信息降低估计的熵
+	if len(policy.Violations("Kettle-Drum-Violet-7", "kettledrumviolet", "Kettle-Drum-Violet")) == 0 {
+		t.Error("user inputs ignored")
+	}
+	if len((&Policy{}).Violations("")) != 0 {
+		t.Error("empty policy")
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "policy.toml")
+	ioutil.WriteFile(path, []byte("min_length = 12\nclasses = [\"upper\"]\nmax_age = \"90d\"\n"), 0644)
+	policy, err := LoadPolicy(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policy.MinLength != 12 || time.Duration(policy.MaxAge) != 90*24*time.Hour {
+		t.Fatalf("%+v", policy)
+	}
+	invalid := map[string]error{
+		"min_length = -1\n":       ErrorPolicyLimit,
+		"classes = [\"emoji\"]\n": ErrorPolicyClass,
+	}
+	for content, expected := range invalid {
+		ioutil.WriteFile(path, []byte(content), 0644)
+		if _, err := LoadPolicy(path); !errors.Is(err, expected) {
+			t.Errorf("%q: %v", content, err)
+		}
+	}
+	ioutil.WriteFile(path, []byte("min_lenght = 12\n"), 0644)
+	if _, err := LoadPolicy(path); err == nil {
+		t.Error("unknown key accepted")
+	}
+}
+
+func TestPolicyAge(t *testing.T) {
+	policy := &Policy{MaxAge: Duration(30 * 24 * time.Hour)}
+	cases := []struct {
+		keyTime int64
+		err     error
+	}{
+		{time.Now().Add(-29 * 24 * time.Hour).Unix(), nil},
+		{time.Now().Add(-31 * 24 * time.Hour).Unix(), ErrorPasswordAge},
+		//旧文件没有记录时间
+		{0, nil},
+	}
+	for _, c := range cases {
+		if err := policy.CheckAge(&Header{KeyTime: c.keyTime}); !errors.Is(err, c.err) {
+			t.Errorf("%d: got %v, want %v", c.keyTime, err, c.err)
+		}
+	}
+	if err := (&Policy{}).CheckAge(&Header{KeyTime: 1}); err != nil {
+		t.Errorf("no limit: %v", err)
+	}
+	//加密时写入的时间
+	dir := t.TempDir()
+	path := filepath.Join(dir, "plain.scc")
+	ioutil.WriteFile(path, encryptBytes(t, testPlain(100)), 0644)
+	if err := policy.CheckFile(path); err != nil {
+		t.Errorf("new file: %v", err)
+	}
+}
@@ -1,20 +1,20 @@
 package zzdm
 
 import (
+	"context"
 	"encoding/binary"
-	"path/filepath"
-	"hash/adler32"
-	"math/rand"
-	"strings"
-	"time"
 	"fmt"
-	"os"
 	"io"
+	"path/filepath"
+	"strings"
 )
 
 //写入文件头
-func WriteHead(file *os.File, fileName []byte, secret bool, frames int64) error {
-	head := Header{frames, fileName, secret}
+func WriteHead(file io.Writer, fileName []byte, secret bool, frames int64) error {
+	return writeHeader(file, &Header{Frames: frames, Name: fileName, Secret: secret})
+}
+
+func writeHeader(file io.Writer, head *Header) error {
 	bytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(bytes, 8848)
 	file.Write(bytes)
@@ -31,26 +31,33 @@ func WriteHead(file *os.File, fileName []byte, secret bool, frames int64) error
 }
 
 //写入数据帧
-func WriteFrame(file *os.File, iv, data []byte, hashcode uint32) error {
-	frame := Frame{
-		iv,
-		data,
-		hashcode,
+func WriteFrame(file io.Writer, iv, data []byte, hashcode uint32) error {
+	return writeFrame(file, &Frame{Iv: iv, Data: data, Hash: hashcode})
+}
+
+func writeFrame(file io.Writer, frame *Frame) error {
+	record, err := frameRecord(frame)
+	if err != nil {
+		return err
 	}
+	_, err = file.Write(record)
+	return err
+}
+
+//帧在文件中的记录:8字节长度+帧的编码
+func frameRecord(frame *Frame) ([]byte, error) {
 	message, err := frame.Marshal()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	length := uint64(len(message))
-	bytes := make([]byte, 8)
-	binary.BigEndian.PutUint64(bytes, length)
-	file.Write(bytes)
-	file.Write(message)
-	return nil
+	record := make([]byte, 8+len(message))
+	binary.BigEndian.PutUint64(record, uint64(len(message)))
+	copy(record[8:], message)
+	return record, nil
 }
 
 //读取文件头
-func ReadHead(file *os.File) (*Header, error) {
+func ReadHead(file io.Reader) (*Header, error) {
 
 	tag, err := ReadUInt64Value(file)
 	if tag != 8848 {
@@ -70,8 +77,7 @@ func ReadHead(file *os.File) (*Header, error) {
 	if uint64(len(bytes)) != size {
 		return nil, ErrorInvalidData
 	}
-	header := &Header{
-	}
+	header := &Header{}
 	err = header.Unmarshal(bytes)
 	if err != nil {
 		return nil, err
@@ -80,9 +86,9 @@ func ReadHead(file *os.File) (*Header, error) {
 }
 
 //读取指定长度的字节
-func ReadBytes(file *os.File, length uint64) ([]byte, error) {
+func ReadBytes(file io.Reader, length uint64) ([]byte, error) {
 	bytes := make([]byte, length)
-	num, err := file.Read(bytes)
+	num, err := io.ReadFull(file, bytes)
 	if err != nil {
 		return nil, err
 	}
@@ -93,9 +99,9 @@ func ReadBytes(file *os.File, length uint64) ([]byte, error) {
 }
 
 //读取数据长度
-func ReadUInt64Value(file *os.File) (uint64, error) {
+func ReadUInt64Value(file io.Reader) (uint64, error) {
 	bytes := make([]byte, 8)
-	num, err := file.Read(bytes)
+	num, err := io.ReadFull(file, bytes)
 	if err != nil {
 		return 0, err
 	}
@@ -107,7 +113,7 @@ func ReadUInt64Value(file *os.File) (uint64, error) {
 }
 
 //读取数据帧
-func ReadFrame(file *os.File) (*Frame, error) {
+func ReadFrame(file io.Reader) (*Frame, error) {
 	length, err := ReadUInt64Value(file)
 	if err != nil {
 		return nil, err
@@ -122,216 +128,100 @@ func ReadFrame(file *os.File) (*Frame, error) {
 	if uint64(len(bytes)) != length {
 		return nil, ErrorInvalidData
 	}
-	frame := &Frame{
-
-	}
+	frame := &Frame{}
 	err = frame.Unmarshal(bytes)
 	return frame, err
 }
 
 //解密文件
 func Decrypt(input, output, password string, force bool) error {
+	return DecryptContext(context.Background(), input, output, password, force)
+}
 
-	file, err := os.Open(input)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
+//解密文件,ctx取消时在帧之间停止并删除未完成的输出文件
+func DecryptContext(ctx context.Context, input, output, password string, force bool) error {
+	return NewDecryptor(compatOptions(false, force, printDecryption)...).Decrypt(ctx, input, output, password)
+}
 
-	header, err := ReadHead(file)
-	if err != nil {
-		return err
-	}
-	if header == nil {
-		return ErrorFileIO
-	}
-	ph := stringBytes(password, 32) //加密密钥
-	div := defaultIv()              //加密向量
-	nameBytes := header.Name
-	secret := header.Secret
-	fileName := ""
-	if secret {
-		nameBytes, err = AesDecrypt(nameBytes, ph, div)
-		if err != nil {
-			return err
-		}
-		if nameBytes == nil {
-			return ErrorAES
-		}
-	}
-	fileName = string(nameBytes)
-	if len(fileName) <= 0 {
-		return ErrorFileIO
-	}
-	fullName := decryptionName(input, output, fileName)
-	//输入文件和输出文件不能相同
-	//这里忽略文件大小写,对于某些系统可能会存在误判
-	if strings.EqualFold(fullName, input) {
-		return ErrorFileName
-	}
-	if Exist(fullName) {
-		if force {
-			err = os.Truncate(fullName, 0)
-			if err != nil {
-				return err
-			}
-		} else {
-			return ErrorFileDuplicated
-		}
-	}
-	ptr, err := Open(fullName)
-	if err != nil {
-		return err
-	}
-	defer ptr.Close()
-	frameCount := header.Frames
-	var index int64 = 0
+//解密数据流
+func DecryptStream(reader io.Reader, writer io.Writer, password string) error {
+	return DecryptStreamContext(context.Background(), reader, writer, password)
+}
 
-	for {
-		frame, err := ReadFrame(file)
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
-			return err
-		}
-		frameData := frame.Data
-		if frameData == nil {
-			return ErrorFileIO
-		}
-		checksum := frame.Hash
-		iv := frame.Iv
-		if iv == nil {
-			return ErrorFileIO
-		}
-		ivDecrypted, err := AesDecrypt(iv, ph, div)
-		if err != nil {
-			return err
-		}
-		data, err := AesDecrypt(frameData, ph, ivDecrypted)
-		if err != nil {
-			return err
-		}
-		checksum2 := adler32.Checksum(data)
-		if checksum2 != checksum {
-			return ErrorChecksumMismatch
-		}
-		size, err := ptr.Write(data)
-		if err != nil {
-			return err
-		}
-		if size != len(data) {
-			return ErrorDataMissing
-		}
-		index += 1
-		fmt.Printf("frame{index=%d,max=%d}\n", index, frameCount)
-	}
-	if index != frameCount {
-		return ErrorFrameMissing
-	}
-	return nil
+//解密数据流,ctx取消时在帧之间停止
+func DecryptStreamContext(ctx context.Context, reader io.Reader, writer io.Writer, password string) error {
+	return NewDecryptor(compatOptions(false, false, printDecryption)...).DecryptStream(ctx, reader, writer, password)
+}
+
+//校验加密文件
+func Verify(input, password string) error {
+	return VerifyContext(context.Background(), input, password)
+}
+
+//校验加密文件,ctx取消时在帧之间停止
+func VerifyContext(ctx context.Context, input, password string) error {
+	return NewDecryptor(compatOptions(false, false, printDecryption)...).Verify(ctx, input, password)
 }
 
 //加密文件
 func Encrypt(input, output, password string, secret, force bool) error {
-	fileName := encryptionName(input, output, secret)
-	//输入文件和输出文件不能相同
-	//这里忽略文件大小写,对于某些系统可能会存在误判
-	if strings.EqualFold(fileName, input) {
-		return ErrorFileName
-	}
-	if Exist(fileName) {
-		if force {
-			err := os.Truncate(fileName, 0)
-			if err != nil {
-				return err
-			}
-		} else {
-			return ErrorFileDuplicated
-		}
-	}
+	return EncryptContext(context.Background(), input, output, password, secret, force)
+}
 
-	fileSize := FileLength(input)
-	left := fileSize % BUFFER
-	frameCount := (fileSize - left) / BUFFER
-	if left > 0 {
-		frameCount ++
-	}
-	ptr, err := Open(fileName)
-	if err != nil {
-		return err
-	}
-	defer ptr.Close()
-	ph := stringBytes(password, 32)
-	div := defaultIv()
-	baseName := filepath.Base(input)
-	baseNameBytes := []byte(baseName)
+//加密文件,ctx取消时在帧之间停止并删除未完成的输出文件
+func EncryptContext(ctx context.Context, input, output, password string, secret, force bool) error {
+	return NewEncryptor(compatOptions(secret, force, printEncryption)...).Encrypt(ctx, input, output, password)
+}
+
+//加密数据流,size为明文长度,name为写入文件头的文件名
+func EncryptStream(reader io.Reader, writer io.Writer, name, password string, secret bool, size int64) error {
+	return EncryptStreamContext(context.Background(), reader, writer, name, password, secret, size)
+}
+
+//加密数据流,ctx取消时在帧之间停止
+func EncryptStreamContext(ctx context.Context, reader io.Reader, writer io.Writer, name, password string, secret bool, size int64) error {
+	return NewEncryptor(compatOptions(secret, false, printEncryption)...).EncryptStream(ctx, reader, writer, name, password, size)
+}
+
+//旧接口的布尔参数转换为配置项
+func compatOptions(secret, force bool, progress ProgressFunc) []Option {
+	naming := NamingOriginal
 	if secret {
-		baseNameBytes, err = AesEncrypt(baseNameBytes, ph, div)
-		if err != nil {
-			return err
-		}
+		naming = NamingSecret
 	}
-	err = WriteHead(ptr, baseNameBytes, secret, frameCount)
-	if err != nil {
-		return err
+	overwrite := OverwriteNever
+	if force {
+		overwrite = OverwriteForce
 	}
-	var index int64 = 0
-	var offset int64 = 0
-	raw, err := os.Open(input)
-	if err != nil {
-		return err
-	}
-	defer raw.Close()
-	rand.Seed(time.Now().UTC().UnixNano())
-	for {
-		buff, checksum, err := Read(raw, offset, BUFFER)
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
-			return err
-		}
-		byteSize := len(buff)
-		offset += int64(byteSize)
-		iv := randomBytes(32, 16) //32个随机字符的字符串的前16个字节
-		ivEncrypt, err := AesEncrypt(iv, ph, div)
-		if err != nil {
-			return err
-		}
-		data, err := AesEncrypt(buff, ph, iv)
-		if err != nil {
-			return err
-		}
+	return []Option{WithNaming(naming), WithOverwrite(overwrite), WithProgress(progress)}
+}
 
-		err = WriteFrame(ptr, ivEncrypt, data, checksum)
-		if err != nil {
-			return err
-		}
-		index ++
-		fmt.Printf("frame{index=%d,max=%d,bytes=%d}\n", index, frameCount, byteSize)
-		if index >= frameCount {
-			break
-		}
-	}
-	return nil
+func printEncryption(progress Progress) {
+	fmt.Printf("frame{index=%d,max=%d,bytes=%d}\n", progress.Index, progress.Frames, progress.Bytes)
+}
+
+func printDecryption(progress Progress) {
+	fmt.Printf("frame{index=%d,max=%d}\n", progress.Index, progress.Frames)
 }
 
-//加密文件保存地址
-func encryptionName(input, output string, hidden bool) string {
+//加密文件保存地址,names不为nil时使用确定性加密的文件名
+func encryptionName(input, output string, names *nameCipher) string {
 	baseName := ""
 	dir := ""
 	var fileName = ""
-	if !hidden {
+	if names == nil {
 		path := filepath.Base(input)
 		extension := filepath.Ext(input)
 		baseName = strings.TrimSuffix(path, extension)
 	} else {
-		rand.Seed(time.Now().UTC().UnixNano())
-		baseName = Md5(RandomString(32))
+		baseName = names.Encode(filepath.Base(input))
 	}
 	if !IsDir(output) {
 		dir = strings.TrimSuffix(input, filepath.Base(input))
+		//没有目录部分的相对路径输出到当前目录
+		if len(dir) == 0 {
+			dir = "."
+		}
 	} else {
 		dir = output
 	}
@@ -349,6 +239,10 @@ func decryptionName(input, output, realName string) string {
 	var fileName = ""
 	if !IsDir(output) {
 		dir = strings.TrimSuffix(input, filepath.Base(input))
+		//没有目录部分的相对路径输出到当前目录
+		if len(dir) == 0 {
+			dir = "."
+		}
 	} else {
 		dir = output
 	}
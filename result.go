@@ -0,0 +1,84 @@
+package zzdm
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	OperationEncrypt = "encrypt"
+	OperationDecrypt = "decrypt"
+	//age格式使用的加密套件
+	ageCipher = "chacha20-poly1305"
+)
+
+//一个文件的加解密结果
+type FileResult struct {
+	//OperationEncrypt或OperationDecrypt
+	Operation string
+	Input     string
+	//输出文件,在确定输出文件之前失败时为空
+	Output string
+	//明文字节数
+	Bytes int64
+	//处理的帧数,age与openssl格式没有帧
+	Frames int64
+	Format string
+	Cipher string
+	//输出文件已存在而按处理策略跳过
+	Skipped bool
+	//耗时
+	Duration time.Duration
+	//为nil时成功
+	Err error
+}
+
+//文件处理完成时的回调,递归处理目录时每个文件调用一次
+type ResultFunc func(result *FileResult)
+
+type resultKey struct{}
+
+//处理一个文件并回调结果,输出文件与帧数由ctx中的结果记录
+//加密后可能删除源文件,明文长度在处理之前读取
+func (o *Options) report(ctx context.Context, operation, input string, run func(ctx context.Context) error) error {
+	result := &FileResult{Operation: operation, Input: input}
+	size := FileLength(input)
+	start := time.Now()
+	err := run(context.WithValue(ctx, resultKey{}, result))
+	if o.Result == nil {
+		return err
+	}
+	result.Duration = time.Since(start)
+	result.Err = err
+	if err == nil && !result.Skipped {
+		if operation == OperationEncrypt {
+			result.Bytes = size
+		} else if len(result.Output) > 0 {
+			result.Bytes = FileLength(result.Output)
+		}
+	}
+	o.Result(result)
+	return err
+}
+
+func contextResult(ctx context.Context) *FileResult {
+	result, _ := ctx.Value(resultKey{}).(*FileResult)
+	return result
+}
+
+//记录输出文件、格式与加密套件
+func reportOutput(ctx context.Context, output, format, cipher string) {
+	if result := contextResult(ctx); result != nil {
+		result.Output = output
+		result.Format = format
+		result.Cipher = cipher
+	}
+}
+
+//其他格式的名称与加密套件
+func formatCipher(extension string, o *Options) (string, string) {
+	if extension == AgeExtension {
+		return FormatAge, ageCipher
+	}
+	return FormatOpenSSL, o.Cipher
+}
@@ -0,0 +1,361 @@
+package zzdm
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+//只按长度前缀遍历文件中完整的帧记录,不读取帧的内容
+//有校验帧时按位置区分:每组ParityData个数据帧之后是ParityShards个校验帧
+type recordWalker struct {
+	file   *os.File
+	header *Header
+	//下一个记录的位置
+	offset int64
+	size   int64
+	//已经遍历的数据帧数
+	frames int64
+	group  int64
+	parity int64
+	//全部帧之后还有签名记录
+	trailer bool
+}
+
+func newRecordWalker(file *os.File, header *Header) (*recordWalker, error) {
+	offset, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return &recordWalker{file: file, header: header, offset: offset, size: info.Size()}, nil
+}
+
+//返回下一个完整记录的位置以及是否为校验帧,没有完整的记录时返回io.EOF
+func (w *recordWalker) next() (int64, bool, error) {
+	prefix := make([]byte, 8)
+	if w.offset+8 > w.size {
+		return 0, false, io.EOF
+	}
+	_, err := w.file.ReadAt(prefix, w.offset)
+	if err != nil {
+		return 0, false, err
+	}
+	length := int64(binary.BigEndian.Uint64(prefix))
+	if length <= 0 || length > w.size-w.offset-8 {
+		return 0, false, io.EOF
+	}
+	if w.frames >= w.header.Frames && w.parity == 0 {
+		w.trailer = true
+		return 0, false, io.EOF
+	}
+	offset := w.offset
+	w.offset += 8 + length
+	if w.parity > 0 {
+		w.parity--
+		return offset, true, nil
+	}
+	w.frames++
+	w.group++
+	if w.header.ParityData > 0 && (w.group == int64(w.header.ParityData) || w.frames == w.header.Frames) {
+		w.group = 0
+		w.parity = int64(w.header.ParityShards)
+	}
+	return offset, false, nil
+}
+
+//是否位于校验分组的边界
+func (w *recordWalker) boundary() bool {
+	return w.group == 0 && w.parity == 0
+}
+
+//读取offset处的帧,不改变文件的读取位置
+func (w *recordWalker) read(offset int64) (*Frame, error) {
+	return ReadFrame(io.NewSectionReader(w.file, offset, w.size-offset))
+}
+
+//读取并解密index帧
+func (w *recordWalker) open(offset, index int64, keys *fileKeys) ([]byte, error) {
+	frame, err := w.read(offset)
+	if err != nil {
+		return nil, err
+	}
+	task := &frameTask{index: index, iv: frame.Iv, data: frame.Data, checksum: frame.Hash, mac: frame.Mac}
+	task.open(keys)
+	return task.plain, task.err
+}
+
+//续传时的断点
+type checkpoint struct {
+	//断点之后的内容将被截断
+	offset int64
+	//断点之前的数据帧数
+	frames int64
+	//最后一个数据帧的位置
+	last int64
+}
+
+//继续加密未完成的输出文件,文件头即为断点,最后一个完整的帧与输入一致时从之后的位置继续
+//无法确认输出文件是这个输入未完成的输出时返回false,由调用者按处理策略处理,不会改动这个文件
+func (e *Encryptor) resume(ctx context.Context, input, fileName, password string) (bool, error) {
+	o := &e.options
+	ptr, err := os.OpenFile(fileName, os.O_RDWR, 0)
+	if err != nil {
+		return false, nil
+	}
+	defer ptr.Close()
+	header, err := ReadHead(ptr)
+	if err != nil || header == nil {
+		return false, nil
+	}
+	//口令错误或旧格式的帧没有认证码时都无法确认
+	keys, err := o.headerKeys(header, password)
+	if err != nil || keys.mac == nil {
+		return false, nil
+	}
+	meta, err := openMetadata(header, keys.data)
+	if err != nil {
+		return false, nil
+	}
+	name, err := headerName(header, keys.data, password, nil)
+	if err != nil {
+		return false, nil
+	}
+	info, err := os.Stat(input)
+	if err != nil {
+		return true, err
+	}
+	if meta == nil || name != filepath.Base(input) || info.Size() != meta.Length {
+		return false, nil
+	}
+	if meta.Mtime != 0 && meta.Mtime != info.ModTime().UnixNano() {
+		return false, nil
+	}
+	raw, err := os.Open(input)
+	if err != nil {
+		return true, err
+	}
+	defer raw.Close()
+
+	walker, err := newRecordWalker(ptr, header)
+	if err != nil {
+		return true, err
+	}
+	//保留最近的两个断点,最后一个帧没有写完整时退回上一个
+	first := walker.offset
+	points := []checkpoint{{offset: walker.offset}}
+	var last int64 = 0
+	for {
+		offset, parity, err := walker.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return true, err
+		}
+		if !parity {
+			last = offset
+		}
+		if walker.boundary() {
+			points = append(points, checkpoint{walker.offset, walker.frames, last})
+			if len(points) > 2 {
+				points = points[1:]
+			}
+		}
+	}
+	frameSize := header.FrameSize
+	var point *checkpoint
+	for i := len(points) - 1; i >= 0; i-- {
+		if points[i].frames == 0 {
+			point = &points[i]
+			break
+		}
+		plain, err := walker.open(points[i].last, points[i].frames-1, keys)
+		if err != nil {
+			continue
+		}
+		//填充部分在输入文件之外,读取不到的字节保持为0
+		expected := make([]byte, len(plain))
+		raw.ReadAt(expected, (points[i].frames-1)*frameSize)
+		if !bytes.Equal(plain, expected) {
+			return false, nil
+		}
+		point = &points[i]
+		break
+	}
+	if point == nil {
+		return false, nil
+	}
+	//已经完成并签名的文件保持不变,只有换成另一个签名者时才重新签名
+	if walker.trailer && point.offset == walker.offset {
+		trailer, err := walker.read(walker.offset)
+		if err == nil && (o.SigningKey == nil || bytes.Equal(trailer.Signer, o.SigningKey.Public().(ed25519.PublicKey))) {
+			o.Logger.Printf("%s is already complete", fileName)
+			return true, nil
+		}
+	}
+	//签名包括保留的帧,截断会去掉原有的签名记录
+	var digest *signatureDigest
+	if o.SigningKey != nil {
+		digest = newSignatureDigest(header)
+		kept := &recordWalker{file: ptr, header: header, offset: first, size: walker.size}
+		for kept.frames < point.frames {
+			offset, parity, err := kept.next()
+			if err != nil {
+				return true, err
+			}
+			if parity {
+				continue
+			}
+			frame, err := kept.read(offset)
+			if err != nil {
+				return true, err
+			}
+			digest.add(frame.Mac)
+		}
+	}
+	err = ptr.Truncate(point.offset)
+	if err != nil {
+		return true, err
+	}
+	_, err = ptr.Seek(point.offset, io.SeekStart)
+	if err != nil {
+		return true, err
+	}
+	start := point.frames * frameSize
+	if start > meta.Length {
+		start = meta.Length
+	}
+	_, err = raw.Seek(start, io.SeekStart)
+	if err != nil {
+		return true, err
+	}
+	source := &countReader{reader: raw}
+	padded := meta.Length + meta.Padding
+	reader := io.MultiReader(io.LimitReader(source, meta.Length-start), &zeroReader{padded - point.frames*frameSize - (meta.Length - start)})
+	o.Logger.Printf("resume encryption of %s at frame %d", input, point.frames)
+	err = e.encryptFrames(ctx, reader, ptr, header, keys, digest, point.frames)
+	if err != nil {
+		return true, err
+	}
+	if source.count < meta.Length-start {
+		return true, ErrorDataMissing
+	}
+	if digest != nil {
+		return true, writeFrame(ptr, signatureFrame(o.SigningKey, digest.sum()))
+	}
+	return true, nil
+}
+
+//继续解密未完成的输出文件,最后一个完整的帧与之后不完整的部分都与密文一致时从之后的位置继续
+//空文件从头开始,无法确认输出文件是这个输入未完成的输出时返回false,由调用者按处理策略处理
+func (d *Decryptor) resume(ctx context.Context, file *os.File, fullName string, header *Header, keys *fileKeys, meta *Metadata) (bool, error) {
+	o := &d.options
+	ptr, err := os.OpenFile(fullName, os.O_RDWR, 0)
+	if err != nil {
+		return false, nil
+	}
+	defer ptr.Close()
+	info, err := ptr.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		return false, nil
+	}
+	size := info.Size()
+	if meta != nil && size > meta.Length {
+		return false, nil
+	}
+	frameSize := frameLength(header)
+	frames := size / frameSize
+	if frames > header.Frames {
+		return false, nil
+	}
+	walker, err := newRecordWalker(file, header)
+	if err != nil {
+		return true, err
+	}
+	var last int64 = 0
+	//跳过的帧同样需要计入签名
+	digest := newSignatureDigest(header)
+	for walker.frames < frames {
+		offset, parity, err := walker.next()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return true, err
+		}
+		if !parity {
+			last = offset
+			frame, err := walker.read(offset)
+			if err != nil {
+				return true, err
+			}
+			digest.add(frame.Mac)
+		}
+	}
+	//已写入的明文与解密的结果比较,最后一个完整的帧之后不完整的部分同样比较后截断
+	matches := func(offset, index int64, start int64) bool {
+		plain, err := walker.open(offset, index, keys)
+		if err != nil {
+			return false
+		}
+		if meta != nil && start+int64(len(plain)) > meta.Length {
+			plain = plain[:meta.Length-start]
+		}
+		if length := size - start; length < int64(len(plain)) {
+			plain = plain[:length]
+		}
+		written := make([]byte, len(plain))
+		_, err = ptr.ReadAt(written, start)
+		return err == nil && bytes.Equal(plain, written)
+	}
+	if frames > 0 && !matches(last, frames-1, (frames-1)*frameSize) {
+		return false, nil
+	}
+	if tail := size - frames*frameSize; tail > 0 {
+		next := *walker
+		offset, parity, err := next.next()
+		for err == nil && parity {
+			offset, parity, err = next.next()
+		}
+		if err != nil || !matches(offset, frames, frames*frameSize) {
+			return false, nil
+		}
+	}
+	length := frames * frameSize
+	if meta != nil && length > meta.Length {
+		length = meta.Length
+	}
+	err = ptr.Truncate(length)
+	if err != nil {
+		return true, err
+	}
+	_, err = ptr.Seek(length, io.SeekStart)
+	if err != nil {
+		return true, err
+	}
+	//校验帧由decryptFrames跳过
+	_, err = file.Seek(walker.offset, io.SeekStart)
+	if err != nil {
+		return true, err
+	}
+	o.Logger.Printf("resume decryption of %s at frame %d", fullName, frames)
+	return true, d.decryptFrames(ctx, file, ptr, header, keys, meta, digest, frames)
+}
+
+//续传的输出不属于这个输入时已经按处理策略处理,报错时说明原因
+func resumeConflict(err error, rejected bool) error {
+	if rejected && errors.Is(err, ErrorFileDuplicated) {
+		return fmt.Errorf("%w: %v", ErrorFileDuplicated, ErrorResume)
+	}
+	return err
+}
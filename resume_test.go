@@ -0,0 +1,159 @@
+package zzdm
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResume(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "plain.bin")
+	err := ioutil.WriteFile(input, testPlain(10000), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := []Option{WithKDF(KDFPBKDF2, 1000), WithFrameSize(1024), WithResume(true)}
+	encrypted := filepath.Join(dir, "plain.scc")
+	err = NewEncryptor(options...).Encrypt(context.Background(), input, dir, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	complete, _ := ioutil.ReadFile(encrypted)
+
+	//截断在帧中间的加密输出从最后一个完整的帧之后继续
+	err = os.Truncate(encrypted, int64(len(complete))-1500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = NewEncryptor(options...).Encrypt(context.Background(), input, dir, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := filepath.Join(dir, "out")
+	os.Mkdir(output, 0755)
+	err = NewDecryptor(options...).Decrypt(context.Background(), encrypted, output, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted := filepath.Join(output, "plain.bin")
+	assertSameFile(t, input, decrypted)
+
+	//截断的解密输出,包括不足一帧的情况
+	for _, size := range []int64{0, 500, 5000} {
+		os.Truncate(decrypted, size)
+		err = NewDecryptor(options...).Decrypt(context.Background(), encrypted, output, "password")
+		if err != nil {
+			t.Fatalf("resume decryption at %d: %v", size, err)
+		}
+		assertSameFile(t, input, decrypted)
+	}
+}
+
+func TestResumeSigned(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "plain.bin")
+	ioutil.WriteFile(input, testPlain(10000), 0644)
+	public, key, _ := ed25519.GenerateKey(nil)
+	otherPublic, other, _ := ed25519.GenerateKey(nil)
+	options := []Option{WithKDF(KDFPBKDF2, 1000), WithFrameSize(1024), WithParity(4, 1), WithResume(true)}
+	encrypted := filepath.Join(dir, "plain.scc")
+	err := NewEncryptor(append(options, WithSigningKey(key))...).Encrypt(context.Background(), input, dir, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	complete, _ := ioutil.ReadFile(encrypted)
+	verify := func(signer ed25519.PublicKey) {
+		t.Helper()
+		data, _ := ioutil.ReadFile(encrypted)
+		if !bytes.Equal(decryptBytes(t, data, WithTrustedKeys(Signer{Key: signer})), testPlain(10000)) {
+			t.Fatal("plaintext mismatch")
+		}
+	}
+	//续传的输出重新计算签名
+	os.Truncate(encrypted, int64(len(complete))-3000)
+	err = NewEncryptor(append(options, WithSigningKey(key))...).Encrypt(context.Background(), input, dir, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	verify(public)
+	//已经完成并签名的文件不会丢失签名
+	complete, _ = ioutil.ReadFile(encrypted)
+	err = NewEncryptor(options...).Encrypt(context.Background(), input, dir, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertContent(t, encrypted, complete)
+	//换成另一个签名者时重新签名
+	err = NewEncryptor(append(options, WithSigningKey(other))...).Encrypt(context.Background(), input, dir, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	verify(otherPublic)
+}
+
+func TestResumeForeignOutput(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "plain.bin")
+	ioutil.WriteFile(input, testPlain(10000), 0644)
+	options := []Option{WithKDF(KDFPBKDF2, 1000), WithFrameSize(1024), WithResume(true)}
+	encrypted := filepath.Join(dir, "plain.scc")
+	//与输入无关的文件不能被截断或删除,按处理策略报错
+	victims := [][]byte{[]byte("short"), bytes.Repeat([]byte("victim "), 50), bytes.Repeat([]byte("victim "), 800), testPlain(5000)[1:]}
+	for _, victim := range victims {
+		ioutil.WriteFile(encrypted, victim, 0644)
+		err := NewEncryptor(options...).Encrypt(context.Background(), input, dir, "password")
+		if !errors.Is(err, ErrorFileDuplicated) {
+			t.Fatalf("encrypt over %d bytes: %v", len(victim), err)
+		}
+		assertContent(t, encrypted, victim)
+	}
+	os.Remove(encrypted)
+	err := NewEncryptor(options...).Encrypt(context.Background(), input, dir, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	//另一个口令加密的同名文件同样不属于这个输入
+	other := filepath.Join(dir, "other")
+	os.Mkdir(other, 0755)
+	foreign := filepath.Join(other, "plain.scc")
+	ioutil.WriteFile(foreign, []byte("x"), 0644)
+	err = NewEncryptor(options...).Encrypt(context.Background(), input, other, "another password")
+	if !errors.Is(err, ErrorFileDuplicated) {
+		t.Fatalf("encrypt over a foreign file: %v", err)
+	}
+
+	output := filepath.Join(dir, "out")
+	os.Mkdir(output, 0755)
+	decrypted := filepath.Join(output, "plain.bin")
+	for _, victim := range victims {
+		ioutil.WriteFile(decrypted, victim, 0644)
+		err = NewDecryptor(options...).Decrypt(context.Background(), encrypted, output, "password")
+		if !errors.Is(err, ErrorFileDuplicated) {
+			t.Fatalf("decrypt over %d bytes: %v", len(victim), err)
+		}
+		assertContent(t, decrypted, victim)
+	}
+	//按处理策略改名时保留原有的文件
+	err = NewDecryptor(append(options, WithOverwrite(OverwriteRename))...).Decrypt(context.Background(), encrypted, output, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSameFile(t, input, filepath.Join(output, "plain (1).bin"))
+}
+
+func assertContent(t *testing.T, path string, expected []byte) {
+	t.Helper()
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, expected) {
+		t.Fatalf("%s was changed", path)
+	}
+}
@@ -0,0 +1,241 @@
+package zzdm
+
+import (
+	"context"
+	"crypto/hmac"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+//明文中的一段字节
+type Range struct {
+	Offset int64 `json:"offset"`
+	Length int64 `json:"length"`
+}
+
+//抢救的结果
+type SalvageReport struct {
+	//输出文件,输出文件已存在而按处理策略跳过时为空
+	Output string `json:"output"`
+	//文件头中记录的帧数
+	Frames int64 `json:"frames"`
+	//解密成功的帧数
+	Recovered int64 `json:"recovered"`
+	//无法解析而跳过的密文字节数
+	Skipped int64 `json:"skipped"`
+	//丢失的明文字节范围
+	Lost []Range `json:"lost"`
+}
+
+//从损坏的文件中解密所有仍能通过校验的帧,其余部分留下空洞,zeroFill时以0填充
+//新格式按帧认证码确定帧的位置,旧格式只能按跳过的字节数估计
+func (d *Decryptor) Salvage(ctx context.Context, input, output, password string, zeroFill bool) (*SalvageReport, error) {
+	o := &d.options
+	file, err := os.Open(input)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	header, err := ReadHead(file)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, ErrorFileIO
+	}
+	keys, err := o.headerKeys(header, password)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	err = salvageHeader(header, keys, info.Size())
+	if err != nil {
+		return nil, err
+	}
+	meta, err := openMetadata(header, keys.data)
+	if err != nil {
+		return nil, err
+	}
+	fileName, err := headerName(header, keys.data, password, nil)
+	if err != nil {
+		return nil, err
+	}
+	fullName := decryptionName(input, output, fileName)
+	if len(o.OutputFile) > 0 {
+		fullName = o.OutputFile
+	}
+	if strings.EqualFold(fullName, input) {
+		return nil, ErrorFileName
+	}
+	//按帧的位置写入,输出文件不是追加模式
+	ptr, err := createOutput(ctx, fullName, input, o.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+	if ptr == nil {
+		return &SalvageReport{Frames: header.Frames}, nil
+	}
+	defer ptr.Close()
+	fullName = ptr.Name()
+	o.Logger.Printf("salvage %s -> %s", input, fullName)
+
+	frameSize := frameLength(header)
+	total := header.Frames * frameSize
+	if meta != nil {
+		total = meta.Length
+	}
+	report := &SalvageReport{Output: fullName, Frames: header.Frames}
+	recovered := make([]bool, header.Frames)
+	scanner := newFrameScanner(file, header)
+	var expected int64 = 0
+	//上一个成功解密的帧之后丢失的密文字节数
+	var gap int64 = 0
+	var end int64 = 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		skipped := scanner.skipped
+		frame, err := scanner.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		gap += scanner.skipped - skipped
+		if len(frame.Parity) > 0 || len(frame.Signature) > 0 {
+			continue
+		}
+		task := salvageFrame(frame, keys, expected, gap, int64(scanner.last), frameSize, header.Frames)
+		if task == nil {
+			gap += int64(scanner.last)
+			continue
+		}
+		offset := task.index * frameSize
+		plain := task.plain
+		if meta != nil {
+			if offset >= total {
+				plain = nil
+			} else if offset+int64(len(plain)) > total {
+				plain = plain[:total-offset]
+			}
+		}
+		_, err = ptr.WriteAt(plain, offset)
+		if err != nil {
+			return nil, err
+		}
+		if offset+int64(len(plain)) > end {
+			end = offset + int64(len(plain))
+		}
+		if !recovered[task.index] {
+			recovered[task.index] = true
+			report.Recovered++
+		}
+		expected = task.index + 1
+		gap = 0
+	}
+	//没有元数据时只有最后一帧能确定明文长度
+	if meta == nil && header.Frames > 0 && recovered[header.Frames-1] {
+		total = end
+	}
+	report.Skipped = scanner.skipped
+	for index, ok := range recovered {
+		offset := int64(index) * frameSize
+		if ok || offset >= total {
+			continue
+		}
+		length := frameSize
+		if offset+length > total {
+			length = total - offset
+		}
+		last := len(report.Lost) - 1
+		if last >= 0 && report.Lost[last].Offset+report.Lost[last].Length == offset {
+			report.Lost[last].Length += length
+		} else {
+			report.Lost = append(report.Lost, Range{offset, length})
+		}
+	}
+	if zeroFill {
+		zero := make([]byte, frameSize)
+		for _, lost := range report.Lost {
+			for offset := lost.Offset; offset < lost.Offset+lost.Length; offset += frameSize {
+				size := lost.Offset + lost.Length - offset
+				if size > frameSize {
+					size = frameSize
+				}
+				_, err = ptr.WriteAt(zero[:size], offset)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	//末尾丢失的部分同样留下空洞
+	err = ptr.Truncate(total)
+	if err != nil {
+		return nil, err
+	}
+	if o.Preserve && meta != nil {
+		ptr.Close()
+		err = restoreMetadata(fullName, meta, o.Logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+//按帧数分配空间之前检查文件头,旧格式的文件头没有认证码,损坏的帧数与帧大小以输入文件的大小为限
+//每个帧记录至少有长度前缀与一个字节,帧大小超过默认值时不会超过整个文件
+func salvageHeader(header *Header, keys *fileKeys, size int64) error {
+	if header.Frames < 0 || header.FrameSize < 0 {
+		return ErrorInvalidData
+	}
+	frameSize := frameLength(header)
+	if header.Frames > math.MaxInt64/frameSize {
+		return ErrorInvalidData
+	}
+	if keys.mac == nil && (header.Frames > size/9 || frameSize > BUFFER && frameSize > size) {
+		return ErrorInvalidData
+	}
+	return nil
+}
+
+//确定帧的序号并解密,失败时返回nil
+//gap为上一个成功解密的帧之后丢失的密文字节数,record为当前帧记录的长度
+func salvageFrame(frame *Frame, keys *fileKeys, expected, gap, record, frameSize, frames int64) *frameTask {
+	task := &frameTask{iv: frame.Iv, data: frame.Data, checksum: frame.Hash, mac: frame.Mac}
+	if keys.mac != nil {
+		//每个帧记录都比明文帧长,按帧大小估计的丢失帧数不会偏少
+		last := expected + gap/frameSize + 8
+		if last > frames {
+			last = frames
+		}
+		task.index = -1
+		for index := expected; index < last; index++ {
+			if hmac.Equal(frame.Mac, keys.frameMac(index, frame.Iv, frame.Data)) {
+				task.index = index
+				break
+			}
+		}
+		if task.index < 0 {
+			return nil
+		}
+	} else {
+		task.index = expected + (gap+record/2)/record
+		if task.index >= frames {
+			return nil
+		}
+	}
+	task.open(keys)
+	if task.err != nil {
+		return nil
+	}
+	return task
+}
@@ -0,0 +1,64 @@
+package zzdm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"testing"
+)
+
+func TestSalvage(t *testing.T) {
+	dir := t.TempDir()
+	plain := testPlain(10000)
+	encrypted := encryptBytes(t, plain, WithFrameSize(1024))
+	header, frames := readRecords(t, encrypted)
+	//丢失第3帧,其余的帧照常解密
+	var buffer bytes.Buffer
+	writeRecords(t, &buffer, header, append(frames[:2:2], frames[3:]...))
+	input := filepath.Join(dir, "plain.scc")
+	ioutil.WriteFile(input, buffer.Bytes(), 0644)
+	output := filepath.Join(dir, "out")
+	report, err := NewDecryptor(WithOutputFile(output)).Salvage(context.Background(), input, dir, "password", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Recovered != 9 || len(report.Lost) != 1 || report.Lost[0] != (Range{2048, 1024}) {
+		t.Fatalf("report: %+v", report)
+	}
+	expected := append([]byte{}, plain...)
+	copy(expected[2048:3072], make([]byte, 1024))
+	assertContent(t, output, expected)
+}
+
+func TestSalvageCorruptedHeader(t *testing.T) {
+	dir := t.TempDir()
+	data, _ := ioutil.ReadFile("testdata/v1/multi.scc")
+	_, err := NewDecryptor(WithOutputFile(filepath.Join(dir, "multi.txt"))).Salvage(context.Background(), "testdata/v1/multi.scc", dir, legacyPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSameFile(t, "testdata/v1/multi.txt", filepath.Join(dir, "multi.txt"))
+	//旧格式的文件头没有认证码,损坏的帧数与帧大小不能导致崩溃或耗尽内存
+	changes := []func(header *Header){
+		func(header *Header) { header.Frames = -1 },
+		func(header *Header) { header.Frames = math.MaxInt64 },
+		func(header *Header) { header.Frames = 1 << 40 },
+		func(header *Header) { header.FrameSize = -1 },
+		func(header *Header) { header.FrameSize = 1 << 50 },
+	}
+	for i, change := range changes {
+		header, frames := readRecords(t, data)
+		change(header)
+		var buffer bytes.Buffer
+		writeRecords(t, &buffer, header, frames)
+		input := filepath.Join(dir, "multi.scc")
+		ioutil.WriteFile(input, buffer.Bytes(), 0644)
+		_, err := NewDecryptor(WithOutputFile(filepath.Join(dir, "out"))).Salvage(context.Background(), input, dir, legacyPassword, false)
+		if !errors.Is(err, ErrorInvalidData) {
+			t.Errorf("change %d: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package zzdm
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+)
+
+//每帧的明文字节数,旧格式的文件头中没有记录
+func frameLength(header *Header) int64 {
+	if header.FrameSize <= 0 {
+		return BUFFER
+	}
+	return header.FrameSize
+}
+
+//顺序读取帧记录,长度前缀或内容损坏时逐字节向后查找下一个能够解析的帧
+type frameScanner struct {
+	reader *bufio.Reader
+	limit  int
+	//跳过的字节数
+	skipped int64
+	//上一个帧记录的长度
+	last int
+}
+
+func newFrameScanner(reader io.Reader, header *Header) *frameScanner {
+	limit := int(frameLength(header))
+	//数据帧与校验帧都只比明文帧多出向量、认证码、填充与字段开销
+	limit += 1024
+	return &frameScanner{reader: bufio.NewReaderSize(reader, limit+8), limit: limit}
+}
+
+func (s *frameScanner) next() (*Frame, error) {
+	for {
+		prefix, err := s.reader.Peek(8)
+		if len(prefix) < 8 {
+			if err != nil && err != io.EOF {
+				return nil, err
+			}
+			s.skipped += int64(len(prefix))
+			s.reader.Discard(len(prefix))
+			return nil, io.EOF
+		}
+		length := binary.BigEndian.Uint64(prefix)
+		if length > 0 && length <= uint64(s.limit) {
+			record, err := s.reader.Peek(8 + int(length))
+			if err == nil {
+				frame := &Frame{}
+				if frame.Unmarshal(record[8:]) == nil && validFrame(frame) {
+					s.last = len(record)
+					s.reader.Discard(len(record))
+					return frame, nil
+				}
+			} else if err != io.EOF {
+				return nil, err
+			}
+		}
+		s.reader.Discard(1)
+		s.skipped++
+	}
+}
+
+//数据帧、校验帧与签名记录的字段不能混用
+func validFrame(frame *Frame) bool {
+	if len(frame.Signature) > 0 {
+		return len(frame.Iv) == 0 && len(frame.Data) == 0 && len(frame.Parity) == 0 && len(frame.Mac) == 0
+	}
+	if len(frame.Mac) != 0 && len(frame.Mac) != sha256.Size {
+		return false
+	}
+	if len(frame.Parity) > 0 {
+		return len(frame.Iv) == 0 && len(frame.Data) == 0
+	}
+	return len(frame.Iv) > 0 && len(frame.Data) > 0
+}
@@ -0,0 +1,193 @@
+package zzdm
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+const (
+	//份额文件的扩展名,之后是份额的序号
+	ShareExtension = ".share"
+	//份额的最大数量,序号占一个字节且不能为0
+	maxShares  = 255
+	armorShare = "ZZDM SHARE"
+)
+
+//GF(2^8)上的乘法,约化多项式为x^8+x^4+x^3+x+1
+func gfMul(a, b byte) byte {
+	var product byte
+	for b > 0 {
+		if b&1 != 0 {
+			product ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return product
+}
+
+//乘法逆元a^254,a不能为0
+func gfInv(a byte) byte {
+	result := byte(1)
+	for i := 0; i < 254; i++ {
+		result = gfMul(result, a)
+	}
+	return result
+}
+
+func checkShares(shares, threshold int) error {
+	if threshold < 2 || shares < threshold || shares > maxShares {
+		return ErrorShares
+	}
+	return nil
+}
+
+//将secret拆分为shares份,任意threshold份可以还原
+//每份的第一个字节为x坐标,之后是每个字节对应的多项式在x处的值
+func splitSecret(secret []byte, shares, threshold int) ([][]byte, error) {
+	err := checkShares(shares, threshold)
+	if err != nil {
+		return nil, err
+	}
+	result := make([][]byte, shares)
+	for i := range result {
+		result[i] = make([]byte, len(secret)+1)
+		result[i][0] = byte(i + 1)
+	}
+	coefficients := make([]byte, threshold)
+	for j, b := range secret {
+		coefficients[0] = b
+		_, err = rand.Read(coefficients[1:])
+		if err != nil {
+			return nil, err
+		}
+		for i := range result {
+			x := result[i][0]
+			//秦九韶算法求多项式的值
+			var y byte
+			for k := threshold - 1; k >= 0; k-- {
+				y = gfMul(y, x) ^ coefficients[k]
+			}
+			result[i][j+1] = y
+		}
+	}
+	return result, nil
+}
+
+//拉格朗日插值求多项式在0处的值,份额的数量需要达到拆分时的threshold
+func combineShares(shares [][]byte) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, ErrorShareMissing
+	}
+	length := len(shares[0])
+	for i, share := range shares {
+		if len(share) != length || length < 2 || share[0] == 0 {
+			return nil, ErrorShares
+		}
+		for _, other := range shares[:i] {
+			if other[0] == share[0] {
+				return nil, ErrorShares
+			}
+		}
+	}
+	secret := make([]byte, length-1)
+	for i, share := range shares {
+		//基函数在0处的值
+		basis := byte(1)
+		for j, other := range shares {
+			if i == j {
+				continue
+			}
+			basis = gfMul(basis, gfMul(other[0], gfInv(other[0]^share[0])))
+		}
+		for k := range secret {
+			secret[k] ^= gfMul(basis, share[k+1])
+		}
+	}
+	return secret, nil
+}
+
+//以文本格式写入份额文件,除非强制覆盖,已存在的文件不会被替换
+func writeShare(path string, share *Share, overwrite int) error {
+	message, err := share.Marshal()
+	if err != nil {
+		return err
+	}
+	flag := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if overwrite == OverwriteForce {
+		flag = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+	file, err := os.OpenFile(path, flag, 0600)
+	if os.IsExist(err) {
+		return ErrorFileDuplicated
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	writer, err := newArmorWriter(file, armorShare)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(message)
+	if err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+//读取份额文件
+func readShare(path string) (*Share, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	reader, err := newArmorReader(bufio.NewReader(file), armorShare)
+	if err != nil {
+		return nil, err
+	}
+	message, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	share := &Share{}
+	err = share.Unmarshal(message)
+	if err != nil {
+		return nil, ErrorShares
+	}
+	return share, nil
+}
+
+//份额文件的路径
+func sharePath(fileName string, index int) string {
+	return fmt.Sprintf("%s%s%d", fileName, ShareExtension, index)
+}
+
+//由份额文件还原shamir密钥槽中的主密钥
+func (o *Options) shareKey(slot *KeySlot) ([]byte, error) {
+	shares := make([][]byte, 0, len(o.ShareFiles))
+	for _, path := range o.ShareFiles {
+		share, err := readShare(path)
+		if err != nil {
+			return nil, err
+		}
+		//其他文件的份额不能混用
+		if !bytes.Equal(share.Id, slot.Id) {
+			return nil, ErrorShareMismatch
+		}
+		shares = append(shares, share.Value)
+	}
+	if len(shares) < int(slot.Threshold) {
+		return nil, ErrorShareMissing
+	}
+	return combineShares(shares)
+}
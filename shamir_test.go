@@ -0,0 +1,147 @@
+package zzdm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSplitSecret(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	shares, err := splitSecret(secret, 5, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	//任意3份可以还原,不足3份时得到的是无关的值
+	for mask := 1; mask < 1<<5; mask++ {
+		subset := make([][]byte, 0)
+		for i := range shares {
+			if mask&(1<<uint(i)) != 0 {
+				subset = append(subset, shares[i])
+			}
+		}
+		combined, err := combineShares(subset)
+		if err != nil {
+			t.Fatalf("subset %05b: %v", mask, err)
+		}
+		if (len(subset) >= 3) != bytes.Equal(combined, secret) {
+			t.Fatalf("subset %05b of %d shares", mask, len(subset))
+		}
+	}
+	//x坐标重复、为0或长度不一致的份额
+	invalid := [][][]byte{
+		{shares[0], shares[1], shares[0]},
+		{shares[0], shares[1], append([]byte{0}, shares[2][1:]...)},
+		{shares[0], shares[1], shares[2][:10]},
+	}
+	for i, subset := range invalid {
+		if _, err := combineShares(subset); !errors.Is(err, ErrorShares) {
+			t.Errorf("invalid subset %d: %v", i, err)
+		}
+	}
+	if _, err := combineShares(nil); !errors.Is(err, ErrorShareMissing) {
+		t.Errorf("no shares: %v", err)
+	}
+	for _, c := range [][2]int{{3, 1}, {2, 3}, {256, 2}} {
+		if _, err := splitSecret(secret, c[0], c[1]); !errors.Is(err, ErrorShares) {
+			t.Errorf("%d of %d: %v", c[1], c[0], err)
+		}
+	}
+	for a := 1; a < 256; a++ {
+		if gfMul(byte(a), gfInv(byte(a))) != 1 {
+			t.Fatalf("inverse of %d", a)
+		}
+	}
+}
+
+func TestKeySlots(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "plain.bin")
+	ioutil.WriteFile(input, testPlain(5000), 0644)
+	encrypted := filepath.Join(dir, "plain.scc")
+	err := NewEncryptor(WithKDF(KDFPBKDF2, 1000), WithShares(3, 2)).Encrypt(context.Background(), input, dir, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	share := func(index int) string {
+		return sharePath(encrypted, index)
+	}
+	//另一个文件的份额
+	other := filepath.Join(dir, "other")
+	os.Mkdir(other, 0755)
+	err = NewEncryptor(WithKDF(KDFPBKDF2, 1000), WithShares(3, 2)).Encrypt(context.Background(), input, other, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreign := sharePath(filepath.Join(other, "plain.scc"), 1)
+	cases := []struct {
+		name     string
+		password string
+		shares   []string
+		err      error
+	}{
+		{"password slot", "password", nil, nil},
+		{"shares 1 and 2", "", []string{share(1), share(2)}, nil},
+		{"shares 3 and 1", "", []string{share(3), share(1)}, nil},
+		{"all shares", "", []string{share(1), share(2), share(3)}, nil},
+		{"shares with a wrong password", "wrong", []string{share(2), share(3)}, nil},
+		{"one share", "", []string{share(2)}, ErrorShareMissing},
+		{"duplicated share", "", []string{share(2), share(2)}, ErrorShares},
+		{"share of another file", "", []string{share(1), foreign}, ErrorShareMismatch},
+		{"wrong password", "wrong", nil, ErrorAuthentication},
+		{"nothing", "", nil, ErrorPassword},
+	}
+	for _, c := range cases {
+		var output bytes.Buffer
+		data, _ := ioutil.ReadFile(encrypted)
+		err := NewDecryptor(WithShareFiles(c.shares...)).DecryptStream(context.Background(), bytes.NewReader(data), &output, c.password)
+		if !errors.Is(err, c.err) {
+			t.Errorf("%s: got %v, want %v", c.name, err, c.err)
+			continue
+		}
+		if err == nil && !bytes.Equal(output.Bytes(), testPlain(5000)) {
+			t.Errorf("%s: plaintext mismatch", c.name)
+		}
+	}
+}
+
+func TestShareOutputs(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "plain.bin")
+	ioutil.WriteFile(input, testPlain(5000), 0644)
+	encrypted := filepath.Join(dir, "plain.scc")
+	//已存在的份额文件不被替换,也不留下无法解密的输出
+	ioutil.WriteFile(sharePath(encrypted, 2), []byte("old share"), 0644)
+	err := NewEncryptor(WithKDF(KDFPBKDF2, 1000), WithShares(3, 2)).Encrypt(context.Background(), input, dir, "")
+	if !errors.Is(err, ErrorFileDuplicated) {
+		t.Fatalf("existing share: %v", err)
+	}
+	assertContent(t, sharePath(encrypted, 2), []byte("old share"))
+	for _, path := range []string{encrypted, sharePath(encrypted, 1), sharePath(encrypted, 3)} {
+		if Exist(path) {
+			t.Fatalf("%s left behind", path)
+		}
+	}
+	err = NewEncryptor(WithKDF(KDFPBKDF2, 1000), WithShares(3, 2), WithOverwrite(OverwriteForce)).Encrypt(context.Background(), input, dir, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := ioutil.ReadFile(encrypted)
+	var output bytes.Buffer
+	err = NewDecryptor(WithShareFiles(sharePath(encrypted, 2), sharePath(encrypted, 3))).DecryptStream(context.Background(), bytes.NewReader(data), &output, "")
+	if err != nil || !bytes.Equal(output.Bytes(), testPlain(5000)) {
+		t.Fatalf("overwritten shares: %v", err)
+	}
+	//转换格式时不会生成份额
+	legacy := filepath.Join(dir, "multi.scc")
+	copyFile(t, "testdata/v1/multi.scc", legacy)
+	err = NewEncryptor(WithShares(3, 2)).Migrate(context.Background(), legacy, legacyPassword)
+	if !errors.Is(err, ErrorShareOutput) {
+		t.Fatalf("migrate with shares: %v", err)
+	}
+	assertSameFile(t, "testdata/v1/multi.scc", legacy)
+}
@@ -0,0 +1,127 @@
+package zzdm
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+//删除源文件前默认的覆盖次数
+const DefaultShredPasses = 1
+
+//覆盖passes遍随机数据并同步到磁盘,清空后改为随机文件名再删除
+//写时复制、日志或快照文件系统以及SSD的磨损均衡可能保留原来的数据块,不能保证数据无法恢复
+func Shred(path string, passes int) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return ErrorShred
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	size := info.Size()
+	buffer := make([]byte, 64*1024)
+	for pass := 0; pass < passes; pass++ {
+		for offset := int64(0); offset < size; {
+			chunk := buffer
+			if size-offset < int64(len(chunk)) {
+				chunk = chunk[:size-offset]
+			}
+			if _, err = io.ReadFull(rand.Reader, chunk); err != nil {
+				return err
+			}
+			if _, err = file.WriteAt(chunk, offset); err != nil {
+				return err
+			}
+			offset += int64(len(chunk))
+		}
+		if err = file.Sync(); err != nil {
+			return err
+		}
+	}
+	//清空并同步后文件长度也不再保留
+	if err = file.Truncate(0); err != nil {
+		return err
+	}
+	if err = file.Sync(); err != nil {
+		return err
+	}
+	file.Close()
+	//改名使目录项中不再保留原文件名
+	random := make([]byte, 8)
+	if _, err = rand.Read(random); err != nil {
+		return err
+	}
+	name := filepath.Join(filepath.Dir(path), hex.EncodeToString(random))
+	if err = os.Rename(path, name); err != nil {
+		return err
+	}
+	return os.Remove(name)
+}
+
+//发给age接收者的输出只能由对应的身份解密,其余的输出由口令或刚写入的份额解密
+func (o *Options) canCheckOutput() bool {
+	return o.Format != FormatAge || len(o.Recipients) == 0 || len(o.Identities) > 0
+}
+
+//解密输出文件并与源文件比较SHA-256,一致时粉碎源文件,跳过的文件不处理
+func (e *Encryptor) removeSource(ctx context.Context, input, password string) error {
+	result := contextResult(ctx)
+	if result == nil || result.Skipped || len(result.Output) == 0 {
+		return nil
+	}
+	expected, err := fileDigest(input)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(result.Output)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	//校验时不输出进度,也不改变加密结果中的帧数
+	options := e.options
+	options.Progress = nil
+	options.Result = nil
+	//只有份额时由写在输出文件旁的份额解密
+	if options.Shares > 0 && (options.Format == FormatZZDM || options.Format == "") {
+		options.ShareFiles = nil
+		for i := 1; i <= options.Shares; i++ {
+			options.ShareFiles = append(options.ShareFiles, sharePath(result.Output, i))
+		}
+	}
+	digest := sha256.New()
+	err = NewDecryptor(WithOptions(options)).DecryptStream(context.WithValue(ctx, resultKey{}, (*FileResult)(nil)), file, digest, password)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrorRoundTrip, err)
+	}
+	if !bytes.Equal(digest.Sum(nil), expected) {
+		return ErrorRoundTrip
+	}
+	e.options.Logger.Printf("shred %s, %d passes", input, e.options.ShredPasses)
+	return Shred(input, e.options.ShredPasses)
+}
+
+func fileDigest(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	digest := sha256.New()
+	if _, err = io.Copy(digest, file); err != nil {
+		return nil, err
+	}
+	return digest.Sum(nil), nil
+}
@@ -0,0 +1,67 @@
+package zzdm
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+)
+
+func TestShred(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "secret.txt")
+	ioutil.WriteFile(path, testPlain(100000), 0644)
+	err := Shred(path, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, _ := ioutil.ReadDir(dir)
+	if len(files) != 0 {
+		t.Fatalf("%d files left", len(files))
+	}
+	if err = Shred(dir, 1); !errors.Is(err, ErrorShred) {
+		t.Fatalf("shred a directory: %v", err)
+	}
+}
+
+func TestRemoveSource(t *testing.T) {
+	identity, _ := age.GenerateX25519Identity()
+	identityFile := filepath.Join(t.TempDir(), "identity.txt")
+	ioutil.WriteFile(identityFile, []byte(identity.String()+"\n"), 0600)
+	recipient := identity.Recipient().String()
+	cases := []struct {
+		name     string
+		password string
+		options  []Option
+		err      error
+	}{
+		{"password", "password", nil, nil},
+		{"shares only", "", []Option{WithShares(3, 2)}, nil},
+		{"age password", "password", []Option{WithFormat(FormatAge)}, nil},
+		{"age recipient", "", []Option{WithFormat(FormatAge), WithRecipients(recipient)}, ErrorRemoveSource},
+		{"age recipient with identity", "", []Option{WithFormat(FormatAge), WithRecipients(recipient), WithIdentities(identityFile)}, nil},
+	}
+	for _, c := range cases {
+		dir := t.TempDir()
+		input := filepath.Join(dir, "plain.bin")
+		ioutil.WriteFile(input, testPlain(5000), 0644)
+		options := append([]Option{WithKDF(KDFPBKDF2, 1000), WithRemoveSource(1)}, c.options...)
+		err := NewEncryptor(options...).Encrypt(context.Background(), input, dir, c.password)
+		if !errors.Is(err, c.err) {
+			t.Errorf("%s: got %v, want %v", c.name, err, c.err)
+			continue
+		}
+		//无法校验时源文件保留,也不会写入输出
+		files, _ := ioutil.ReadDir(dir)
+		if c.err != nil && (!Exist(input) || len(files) != 1) {
+			t.Errorf("%s: source removed or output written", c.name)
+		}
+		if _, statErr := os.Stat(input); c.err == nil && !os.IsNotExist(statErr) {
+			t.Errorf("%s: source kept", c.name)
+		}
+	}
+}
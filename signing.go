@@ -0,0 +1,277 @@
+package zzdm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+const (
+	//签名公钥文件的扩展名
+	PublicKeyExtension = ".pub"
+	//公钥文件每行的前缀
+	publicKeyPrefix  = "zzdm-ed25519"
+	signatureContext = "zzdm signature"
+)
+
+//签名者
+type Signer struct {
+	Key ed25519.PublicKey
+	//受信任公钥列表中的备注,没有时为空
+	Name string
+}
+
+//公钥的指纹
+func (s *Signer) Fingerprint() string {
+	sum := sha256.Sum256(s.Key)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+func (s *Signer) String() string {
+	if len(s.Name) > 0 {
+		return s.Name + " (" + s.Fingerprint() + ")"
+	}
+	return s.Fingerprint()
+}
+
+//签名校验通过时的回调
+type SignerFunc func(signer *Signer)
+
+//签名的内容:文件头的认证码与按顺序的数据帧认证码
+//校验帧不参与签名,修复后签名仍然有效
+type signatureDigest struct {
+	hash hash.Hash
+}
+
+func newSignatureDigest(header *Header) *signatureDigest {
+	h := sha256.New()
+	h.Write([]byte(signatureContext))
+	h.Write(header.Mac)
+	return &signatureDigest{hash: h}
+}
+
+func (s *signatureDigest) add(mac []byte) {
+	s.hash.Write(mac)
+}
+
+func (s *signatureDigest) sum() []byte {
+	return s.hash.Sum(nil)
+}
+
+//文件末尾的签名记录
+func signatureFrame(key ed25519.PrivateKey, digest []byte) *Frame {
+	return &Frame{Signature: ed25519.Sign(key, digest), Signer: key.Public().(ed25519.PublicKey)}
+}
+
+//只有zzdm格式有签名记录
+func (o *Options) checkSigning() error {
+	if o.SigningKey != nil && o.Format != FormatZZDM && o.Format != "" {
+		return ErrorFormat
+	}
+	return nil
+}
+
+//校验签名记录,指定了受信任的公钥时必须有签名且签名者在列表中
+func (d *Decryptor) checkSignature(digest []byte, trailer *Frame) error {
+	o := &d.options
+	if d.signed != nil {
+		return d.signed(digest, trailer)
+	}
+	if trailer == nil {
+		if len(o.TrustedKeys) > 0 {
+			return ErrorUnsigned
+		}
+		return nil
+	}
+	if len(trailer.Signer) != ed25519.PublicKeySize || !ed25519.Verify(trailer.Signer, digest, trailer.Signature) {
+		return ErrorSignature
+	}
+	signer := &Signer{Key: trailer.Signer}
+	trusted := len(o.TrustedKeys) == 0
+	for _, key := range o.TrustedKeys {
+		if bytes.Equal(key.Key, trailer.Signer) {
+			signer.Name = key.Name
+			trusted = true
+			break
+		}
+	}
+	if !trusted {
+		return ErrorUntrusted
+	}
+	o.Logger.Printf("signed by %s", signer)
+	if o.Signed != nil {
+		o.Signed(signer)
+	}
+	return nil
+}
+
+//生成签名密钥,私钥以PKCS#8 PEM格式写入path,公钥写入path.pub
+func GenerateSigningKey(path, comment string, overwrite int) (ed25519.PublicKey, error) {
+	for _, name := range []string{path, path + PublicKeyExtension} {
+		err := prepareOutput(name, overwrite)
+		if err != nil {
+			return nil, err
+		}
+	}
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, err
+	}
+	err = ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600)
+	if err != nil {
+		return nil, err
+	}
+	line := publicKeyPrefix + " " + base64.StdEncoding.EncodeToString(public)
+	if len(comment) > 0 {
+		line += " " + comment
+	}
+	err = ioutil.WriteFile(path+PublicKeyExtension, []byte(line+"\n"), 0644)
+	if err != nil {
+		return nil, err
+	}
+	return public, nil
+}
+
+//读取PKCS#8 PEM格式的私钥
+func LoadSigningKey(path string) (ed25519.PrivateKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrorSigningKey
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, ErrorSigningKey
+	}
+	private, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, ErrorSigningKey
+	}
+	return private, nil
+}
+
+//读取受信任的公钥列表,每行一个公钥,之后的内容作为备注,#开头的行被忽略
+func LoadTrustedKeys(path string) ([]Signer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	signers := make([]Signer, 0)
+	lines := bufio.NewScanner(file)
+	for lines.Scan() {
+		line := strings.TrimSpace(lines.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		signer, err := ParsePublicKey(line)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, *signer)
+	}
+	return signers, lines.Err()
+}
+
+//解析公钥文件中的一行,前缀可以省略
+func ParsePublicKey(line string) (*Signer, error) {
+	fields := strings.Fields(line)
+	if len(fields) > 0 && fields[0] == publicKeyPrefix {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return nil, ErrorSigningKey
+	}
+	key, err := base64.StdEncoding.DecodeString(fields[0])
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, ErrorSigningKey
+	}
+	return &Signer{Key: key, Name: strings.Join(fields[1:], " ")}, nil
+}
+
+//校验加密文件的全部帧后重新签名,替换原有的签名
+func (e *Encryptor) Sign(ctx context.Context, input, password string) error {
+	o := &e.options
+	if o.SigningKey == nil {
+		return ErrorSigningKey
+	}
+	file, err := os.OpenFile(input, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	//文本格式中的签名记录位于编码之内,无法原地替换
+	if isArmored(filePrefix(file)) {
+		return ErrorFormat
+	}
+	header, err := ReadHead(file)
+	if err != nil {
+		return err
+	}
+	if header == nil {
+		return ErrorFileIO
+	}
+	keys, err := o.headerKeys(header, password)
+	if err != nil {
+		return err
+	}
+	if keys.mac == nil {
+		return ErrorSign
+	}
+	meta, err := openMetadata(header, keys.data)
+	if err != nil {
+		return err
+	}
+	walker, err := newRecordWalker(file, header)
+	if err != nil {
+		return err
+	}
+	var digest []byte
+	d := &Decryptor{options: *o}
+	d.options.Progress = nil
+	d.signed = func(sum []byte, trailer *Frame) error {
+		digest = sum
+		return nil
+	}
+	err = d.decryptFrames(ctx, file, ioutil.Discard, header, keys, meta, newSignatureDigest(header), 0)
+	if err != nil {
+		return err
+	}
+	for {
+		_, _, err := walker.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	err = file.Truncate(walker.offset)
+	if err != nil {
+		return err
+	}
+	_, err = file.Seek(walker.offset, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	o.Logger.Printf("sign %s", input)
+	return writeFrame(file, signatureFrame(o.SigningKey, digest))
+}
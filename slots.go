@@ -0,0 +1,182 @@
+package zzdm
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"os"
+)
+
+//密钥槽类型
+const (
+	//由口令解开的主密钥
+	SlotPassword = "password"
+	//由份额还原的主密钥
+	SlotShamir = "shamir"
+)
+
+//加密时的主密钥,以及写入文件头的密钥派生参数与密钥槽
+type masterKey struct {
+	key  []byte
+	kdf  string
+	salt []byte
+	cost int
+	//有份额时主密钥随机生成,只保存在密钥槽与份额中
+	slots  []*KeySlot
+	shares [][]byte
+	//不为0时代替当前时间写入文件头,沿用原有的主密钥时口令的使用期限不变
+	keyTime int64
+}
+
+//没有份额时主密钥由口令派生,与之前的版本相同
+//有份额时随机生成主密钥并拆分,同时给出口令时再增加一个口令密钥槽
+func (o *Options) newMasterKey(password string) (*masterKey, error) {
+	if o.Shares <= 0 {
+		salt, err := newSalt(o.KDF)
+		if err != nil {
+			return nil, err
+		}
+		key, err := deriveKey(password, o.Cipher, o.KDF, salt, o.KDFCost)
+		if err != nil {
+			return nil, err
+		}
+		return &masterKey{key: key, kdf: o.KDF, salt: salt, cost: o.KDFCost}, nil
+	}
+	//文件名密钥只能由口令派生
+	if o.Naming == NamingSecret && len(password) == 0 {
+		return nil, ErrorPassword
+	}
+	length, err := keyLength(o.Cipher)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, length)
+	_, err = rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := splitSecret(key, o.Shares, o.Threshold)
+	if err != nil {
+		return nil, err
+	}
+	id, err := randomIv()
+	if err != nil {
+		return nil, err
+	}
+	master := &masterKey{key: key, shares: shares}
+	if len(password) > 0 {
+		salt, err := newSalt(o.KDF)
+		if err != nil {
+			return nil, err
+		}
+		wrapping, err := deriveKey(password, o.Cipher, o.KDF, salt, o.KDFCost)
+		if err != nil {
+			return nil, err
+		}
+		slot := &KeySlot{Type: SlotPassword, Kdf: o.KDF, Salt: salt, Key: xorBytes(key, wrapping)}
+		if len(salt) > 0 {
+			slot.Cost = int32(o.KDFCost)
+		}
+		master.slots = append(master.slots, slot)
+	}
+	master.slots = append(master.slots, &KeySlot{Type: SlotShamir, Threshold: int32(o.Threshold), Shares: int32(o.Shares), Id: id})
+	return master, nil
+}
+
+//在加密之前将份额写入fileName.share1至fileName.shareN,返回写入的文件
+//任何一份写入失败时删除已经写入的份额,不会留下无法解密的输出
+func (m *masterKey) writeShares(fileName string, overwrite int) ([]string, error) {
+	paths := make([]string, 0, len(m.shares))
+	for _, slot := range m.slots {
+		if slot.Type != SlotShamir {
+			continue
+		}
+		for i, value := range m.shares {
+			path := sharePath(fileName, i+1)
+			err := writeShare(path, &Share{Id: slot.Id, Threshold: slot.Threshold, Value: value}, overwrite)
+			if err != nil {
+				removeFiles(paths)
+				return nil, err
+			}
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
+
+func removeFiles(paths []string) {
+	for _, path := range paths {
+		os.Remove(path)
+	}
+}
+
+//由口令或份额解开文件头中的密钥槽,没有密钥槽时由口令直接派生
+func (o *Options) headerKeys(header *Header, password string) (*fileKeys, error) {
+	if len(header.Slots) == 0 {
+		return headerKeys(header, password)
+	}
+	//密钥槽只能由文件头的认证码确认是否解开
+	if header.Version < FormatVersion {
+		return nil, ErrorInvalidFile
+	}
+	var result error = ErrorPassword
+	for _, slot := range header.Slots {
+		var master []byte
+		switch slot.Type {
+		case SlotPassword:
+			if len(password) == 0 {
+				continue
+			}
+			wrapping, err := deriveKey(password, header.Cipher, slot.Kdf, slot.Salt, int(slot.Cost))
+			if err != nil {
+				return nil, err
+			}
+			if len(wrapping) != len(slot.Key) {
+				return nil, ErrorInvalidFile
+			}
+			master = xorBytes(slot.Key, wrapping)
+		case SlotShamir:
+			if len(o.ShareFiles) == 0 {
+				continue
+			}
+			key, err := o.shareKey(slot)
+			if err != nil {
+				result = err
+				continue
+			}
+			master = key
+		default:
+			continue
+		}
+		keys, err := newFileKeys(header, master)
+		if err != nil {
+			return nil, err
+		}
+		err = keys.checkHeader(header)
+		if err == nil {
+			return keys, nil
+		}
+		result = err
+	}
+	return nil, result
+}
+
+//校验文件头的认证码
+func (k *fileKeys) checkHeader(header *Header) error {
+	mac, err := k.headerMac(header)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(mac, header.Mac) {
+		return ErrorAuthentication
+	}
+	return nil
+}
+
+//主密钥与由口令派生的同样长度的密钥异或,每个密钥槽的盐都是随机的,派生的密钥只使用一次
+func xorBytes(a, b []byte) []byte {
+	result := make([]byte, len(a))
+	for i := range a {
+		result[i] = a[i] ^ b[i]
+	}
+	return result
+}
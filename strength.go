@@ -0,0 +1,494 @@
+package zzdm
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	//离线攻击每秒的猜测次数,口令经过KDF派生,远低于普通哈希的速度
+	crackRate = 1e4
+	//每增加一个模式,攻击者需要额外尝试的次数
+	minGuessesBeforeGrowingSequence = 1e4
+	minSubmatchGuessesSingleChar    = 10
+	minSubmatchGuessesMultiChar     = 50
+	bruteforceCardinality           = 10
+	minYearSpace                    = 20
+	//只分析口令的前100个字符,其余的字符不计入猜测次数,估计值只会偏低
+	maxAnalyzedLength = 100
+	//3分以上认为口令足够安全
+	StrongScore = 3
+)
+
+var (
+	allLowerRegex   = regexp.MustCompile(`^[^A-Z]+$`)
+	allUpperRegex   = regexp.MustCompile(`^[^a-z]+$`)
+	startUpperRegex = regexp.MustCompile(`^[A-Z][^A-Z]+$`)
+	endUpperRegex   = regexp.MustCompile(`^[^A-Z]+[A-Z]$`)
+)
+
+//口令强度,参考zxcvbn的方法估计:
+//找出口令中所有可识别的模式,估计每个模式的猜测次数,再求总猜测次数最少的分解
+type PasswordStrength struct {
+	//0至4分
+	Score int
+	//估计的猜测次数
+	Guesses float64
+	//按每秒1万次离线猜测计算的破解时间,单位为秒
+	CrackSeconds float64
+	//破解时间的描述
+	CrackTime string
+	//发现的全部问题,足够安全时为空
+	Problems []string
+	//改进建议
+	Suggestions []string
+	//猜测次数最少的分解
+	Sequence []*PasswordMatch
+}
+
+//口令中的一个模式
+type PasswordMatch struct {
+	Pattern string
+	//在口令中的起止位置,以字符计,包含End
+	Start int
+	End   int
+	Token string
+	//这一部分的猜测次数
+	Guesses float64
+	//字典词,反转或字符替换之后的词
+	Word       string
+	Dictionary string
+	Rank       int
+	Reversed   bool
+	L33t       bool
+	//替换字符与原来的字母
+	Sub map[rune]rune
+	//键盘模式的键盘名称,方向改变的次数与按下shift的次数
+	Graph   string
+	Turns   int
+	Shifted int
+	//重复的单元与次数
+	Base   string
+	Repeat int
+	//序列是否递增
+	Ascending bool
+	//年份与日期
+	Year      int
+	Month     int
+	Day       int
+	Separator string
+
+	graph       *keyboardGraph
+	baseGuesses float64
+}
+
+//估计口令的强度,userInputs为用户名、文件名等攻击者可能知道的个人信息
+func EstimatePassword(password string, userInputs ...string) *PasswordStrength {
+	loadMatching()
+	runes := []rune(password)
+	if len(runes) > maxAnalyzedLength {
+		runes = runes[:maxAnalyzedLength]
+	}
+	user := newRankedDictionary(DictionaryUserInputs, userInputs)
+	guesses, sequence := mostGuessable(runes, user)
+	s := &PasswordStrength{Guesses: guesses, Sequence: sequence}
+	s.Score = guessesScore(guesses)
+	s.CrackSeconds = guesses / crackRate
+	s.CrackTime = displayTime(s.CrackSeconds)
+	s.feedback(utf8.RuneCountInString(password))
+	return s
+}
+
+//动态规划求猜测次数最少的模式分解
+//l个模式组成的分解,攻击者需要尝试l!种排列,并且先尝试模式更少的分解
+func mostGuessable(password []rune, user *rankedDictionary) (float64, []*PasswordMatch) {
+	n := len(password)
+	if n == 0 {
+		return 1, nil
+	}
+	byEnd := make([][]*PasswordMatch, n)
+	for _, m := range omnimatch(password, user) {
+		byEnd[m.End] = append(byEnd[m.End], m)
+	}
+	type step struct {
+		match *PasswordMatch
+		//各部分猜测次数的乘积与总猜测次数
+		product float64
+		guesses float64
+	}
+	//best[k][l]为前k+1个字符由l个模式组成时的最优分解
+	best := make([][]*step, n)
+	update := func(m *PasswordMatch, l int) {
+		k := m.End
+		product := m.estimate(n)
+		if l > 1 {
+			product *= best[m.Start-1][l-1].product
+		}
+		guesses := factorial(l)*product + math.Pow(minGuessesBeforeGrowingSequence, float64(l-1))
+		for other, s := range best[k] {
+			if s != nil && other <= l && s.guesses <= guesses {
+				return
+			}
+		}
+		best[k][l] = &step{match: m, product: product, guesses: guesses}
+	}
+	for k := 0; k < n; k++ {
+		best[k] = make([]*step, n+1)
+		for _, m := range byEnd[k] {
+			if m.Start == 0 {
+				update(m, 1)
+				continue
+			}
+			for l, s := range best[m.Start-1] {
+				if s != nil {
+					update(m, l+1)
+				}
+			}
+		}
+		//穷举的部分不与另一个穷举的部分相邻
+		update(bruteforceMatch(password, 0, k), 1)
+		for i := 1; i <= k; i++ {
+			for l, s := range best[i-1] {
+				if s != nil && s.match.Pattern != PatternBruteforce {
+					update(bruteforceMatch(password, i, k), l+1)
+				}
+			}
+		}
+	}
+	l, guesses := 0, math.Inf(1)
+	for candidate, s := range best[n-1] {
+		if s != nil && s.guesses < guesses {
+			l, guesses = candidate, s.guesses
+		}
+	}
+	sequence := make([]*PasswordMatch, l)
+	for k := n - 1; k >= 0; l-- {
+		m := best[k][l].match
+		sequence[l-1] = m
+		k = m.Start - 1
+	}
+	return guesses, sequence
+}
+
+func bruteforceMatch(password []rune, i, j int) *PasswordMatch {
+	return &PasswordMatch{Pattern: PatternBruteforce, Start: i, End: j, Token: string(password[i : j+1])}
+}
+
+//估计这一部分的猜测次数,作为口令的一部分时至少需要一定的次数
+func (m *PasswordMatch) estimate(length int) float64 {
+	if m.Guesses > 0 {
+		return m.Guesses
+	}
+	size := m.End - m.Start + 1
+	minimum := 1.0
+	if size < length {
+		minimum = minSubmatchGuessesMultiChar
+		if size == 1 {
+			minimum = minSubmatchGuessesSingleChar
+		}
+	}
+	var guesses float64
+	switch m.Pattern {
+	case PatternBruteforce:
+		guesses = math.Min(math.Pow(bruteforceCardinality, float64(size)), math.MaxFloat64)
+		if size == 1 {
+			guesses = math.Max(guesses, minSubmatchGuessesSingleChar+1)
+		} else {
+			guesses = math.Max(guesses, minSubmatchGuessesMultiChar+1)
+		}
+	case PatternDictionary:
+		guesses = float64(m.Rank) * uppercaseVariations(m.Token) * m.l33tVariations()
+		if m.Reversed {
+			guesses *= 2
+		}
+	case PatternSpatial:
+		guesses = m.spatialGuesses()
+	case PatternRepeat:
+		guesses = m.baseGuesses * float64(m.Repeat)
+	case PatternSequence:
+		guesses = m.sequenceGuesses()
+	case PatternYear:
+		guesses = yearSpace(m.Year)
+	case PatternDate:
+		guesses = yearSpace(m.Year) * 365
+		if len(m.Separator) > 0 {
+			guesses *= 4
+		}
+	}
+	m.Guesses = math.Max(guesses, minimum)
+	return m.Guesses
+}
+
+func factorial(n int) float64 {
+	result := 1.0
+	for i := 2; i <= n; i++ {
+		result *= float64(i)
+	}
+	return result
+}
+
+//组合数C(n,k)
+func binomial(n, k int) float64 {
+	if k > n {
+		return 0
+	}
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result = result * float64(n-k+i) / float64(i)
+	}
+	return result
+}
+
+//大小写变化增加的次数,首字母大写、末尾大写与全部大写只算两种
+func uppercaseVariations(token string) float64 {
+	if allLowerRegex.MatchString(token) || strings.ToLower(token) == token {
+		return 1
+	}
+	if startUpperRegex.MatchString(token) || endUpperRegex.MatchString(token) || allUpperRegex.MatchString(token) {
+		return 2
+	}
+	upper, lower := 0, 0
+	for _, c := range token {
+		if c >= 'A' && c <= 'Z' {
+			upper++
+		} else if c >= 'a' && c <= 'z' {
+			lower++
+		}
+	}
+	return variations(upper, lower)
+}
+
+//在a+b个位置中选出1至min(a,b)个的方式
+func variations(a, b int) float64 {
+	if a == 0 || b == 0 {
+		return 2
+	}
+	if b < a {
+		a, b = b, a
+	}
+	result := 0.0
+	for i := 1; i <= a; i++ {
+		result += binomial(a+b, i)
+	}
+	return result
+}
+
+//字符替换增加的次数
+func (m *PasswordMatch) l33tVariations() float64 {
+	if !m.L33t {
+		return 1
+	}
+	lower := string(lowerRunes([]rune(m.Token)))
+	result := 1.0
+	for sub, letter := range m.Sub {
+		result *= variations(strings.Count(lower, string(sub)), strings.Count(lower, string(letter)))
+	}
+	return result
+}
+
+//起始按键、长度与转向次数确定的键盘模式数量
+func (m *PasswordMatch) spatialGuesses() float64 {
+	length := utf8.RuneCountInString(m.Token)
+	guesses := 0.0
+	for i := 2; i <= length; i++ {
+		turns := m.Turns
+		if turns > i-1 {
+			turns = i - 1
+		}
+		for j := 1; j <= turns; j++ {
+			guesses += binomial(i-1, j-1) * float64(m.graph.starts) * math.Pow(m.graph.degree, float64(j))
+		}
+	}
+	if m.Shifted > 0 {
+		guesses *= variations(m.Shifted, length-m.Shifted)
+	}
+	return guesses
+}
+
+//从常见的起点开始的序列更容易被猜到,递减的序列次数加倍
+func (m *PasswordMatch) sequenceGuesses() float64 {
+	first, _ := utf8.DecodeRuneInString(m.Token)
+	base := 26.0
+	if strings.ContainsRune("aAzZ019", first) {
+		base = 4
+	} else if first >= '0' && first <= '9' {
+		base = 10
+	}
+	if !m.Ascending {
+		base *= 2
+	}
+	return base * float64(utf8.RuneCountInString(m.Token))
+}
+
+func yearSpace(year int) float64 {
+	space := abs(year - time.Now().Year())
+	if space < minYearSpace {
+		space = minYearSpace
+	}
+	return float64(space)
+}
+
+func guessesScore(guesses float64) int {
+	const delta = 5
+	switch {
+	case guesses < 1e3+delta:
+		return 0
+	case guesses < 1e6+delta:
+		return 1
+	case guesses < 1e8+delta:
+		return 2
+	case guesses < 1e10+delta:
+		return 3
+	}
+	return 4
+}
+
+func displayTime(seconds float64) string {
+	const (
+		minute  = 60
+		hour    = minute * 60
+		day     = hour * 24
+		month   = day * 31
+		year    = month * 12
+		century = year * 100
+	)
+	units := []struct {
+		size float64
+		name string
+	}{{year, "year"}, {month, "month"}, {day, "day"}, {hour, "hour"}, {minute, "minute"}, {1, "second"}}
+	if seconds < 1 {
+		return "less than a second"
+	}
+	if seconds >= century {
+		return "centuries"
+	}
+	for _, unit := range units {
+		if seconds >= unit.size {
+			n := int64(math.Round(seconds / unit.size))
+			if n == 1 {
+				return fmt.Sprintf("1 %s", unit.name)
+			}
+			return fmt.Sprintf("%d %ss", n, unit.name)
+		}
+	}
+	return "less than a second"
+}
+
+func (s *PasswordStrength) problem(text string) {
+	for _, p := range s.Problems {
+		if p == text {
+			return
+		}
+	}
+	s.Problems = append(s.Problems, text)
+}
+
+func (s *PasswordStrength) suggest(text string) {
+	for _, p := range s.Suggestions {
+		if p == text {
+			return
+		}
+	}
+	s.Suggestions = append(s.Suggestions, text)
+}
+
+//列出分解中每个模式的问题
+func (s *PasswordStrength) feedback(length int) {
+	if length == 0 {
+		s.problem("password is empty")
+		s.suggest("use a few words, avoid common phrases")
+		s.suggest("no need for symbols, digits, or uppercase letters")
+		return
+	}
+	if s.Score >= StrongScore {
+		return
+	}
+	if length == 1 {
+		s.problem("only 1 character long")
+	} else if length < 8 {
+		s.problem(fmt.Sprintf("only %d characters long", length))
+	}
+	s.suggest("add another word or two, uncommon words are better")
+	sole := len(s.Sequence) == 1
+	for _, m := range s.Sequence {
+		switch m.Pattern {
+		case PatternDictionary:
+			s.dictionaryFeedback(m, sole)
+		case PatternSpatial:
+			if m.Turns == 1 {
+				s.problem(fmt.Sprintf("%q is a straight row of keys", m.Token))
+			} else {
+				s.problem(fmt.Sprintf("%q is a short keyboard pattern", m.Token))
+			}
+			s.suggest("use a longer keyboard pattern with more turns")
+		case PatternRepeat:
+			if utf8.RuneCountInString(m.Base) == 1 {
+				s.problem(fmt.Sprintf("%q repeats a single character", m.Token))
+			} else {
+				s.problem(fmt.Sprintf("%q only repeats %q", m.Token, m.Base))
+			}
+			s.suggest("avoid repeated words and characters")
+		case PatternSequence:
+			s.problem(fmt.Sprintf("%q is a sequence", m.Token))
+			s.suggest("avoid sequences")
+		case PatternYear:
+			s.problem(fmt.Sprintf("%q is a recent year", m.Token))
+			s.suggest("avoid recent years and years that are associated with you")
+		case PatternDate:
+			s.problem(fmt.Sprintf("%q is a date", m.Token))
+			s.suggest("avoid dates and years that are associated with you")
+		}
+	}
+}
+
+//单独一个词的问题更严重,两个字符以下的词不单独提示
+func (s *PasswordStrength) dictionaryFeedback(m *PasswordMatch, sole bool) {
+	if utf8.RuneCountInString(m.Token) < 3 && !sole {
+		return
+	}
+	switch m.Dictionary {
+	case DictionaryPasswords:
+		if sole && !m.L33t && !m.Reversed {
+			if m.Rank <= 10 {
+				s.problem("this is a top-10 common password")
+			} else if m.Rank <= 100 {
+				s.problem("this is a top-100 common password")
+			} else {
+				s.problem("this is a very common password")
+			}
+		} else if m.L33t || m.Reversed {
+			s.problem(fmt.Sprintf("%q is similar to a commonly used password", m.Token))
+		} else {
+			s.problem(fmt.Sprintf("%q is a commonly used password", m.Token))
+		}
+	case DictionaryEnglish:
+		if sole {
+			s.problem("a word by itself is easy to guess")
+		} else {
+			s.problem(fmt.Sprintf("%q is a common word", m.Token))
+		}
+	case DictionaryMaleNames, DictionaryFemaleNames, DictionarySurnames:
+		if sole {
+			s.problem("names and surnames by themselves are easy to guess")
+		} else {
+			s.problem(fmt.Sprintf("%q is a common name", m.Token))
+		}
+	case DictionaryUserInputs:
+		s.problem(fmt.Sprintf("%q is personal information", m.Token))
+	}
+	if startUpperRegex.MatchString(m.Token) {
+		s.suggest("capitalization doesn't help very much")
+	} else if allUpperRegex.MatchString(m.Token) && strings.ToLower(m.Token) != m.Token {
+		s.suggest("all-uppercase is almost as easy to guess as all-lowercase")
+	}
+	if m.Reversed && utf8.RuneCountInString(m.Token) >= 4 {
+		s.suggest("reversed words aren't much harder to guess")
+	}
+	if m.L33t {
+		s.suggest("predictable substitutions like '@' instead of 'a' don't help very much")
+	}
+}
@@ -1,16 +1,16 @@
 package zzdm
 
 import (
-	"path/filepath"
-	"hash/adler32"
-	"math/rand"
 	"crypto/md5"
 	"encoding/hex"
-	"strings"
+	"fmt"
+	"hash/adler32"
 	"io"
+	"math/rand"
 	"os"
+	"path/filepath"
 	"regexp"
-	"fmt"
+	"strings"
 )
 
 //固定长度的随机字符串
@@ -29,10 +29,6 @@ func RandomString(length int) string {
 	return builder.String()
 }
 
-func randomBytes(length, capacity int) []byte {
-	return stringBytes(RandomString(length), capacity)
-}
-
 func stringBytes(content string, capacity int) []byte {
 	if capacity < 0 {
 		capacity = 0
@@ -124,18 +120,21 @@ func FileLength(path string) int64 {
 	return stat.Size()
 }
 
+//旧版本文件使用的固定向量,只用于读取旧文件
 func defaultIv() []byte {
 	return stringBytes(fmt.Sprintf("%s", Author), 16)
 }
 
+//按字符种类与长度检查口令,0表示符合全部建议,-1至-5依次表示缺少大写字母、小写字母、数字、长度不足8位与缺少特殊字符
+//需要猜测次数与具体问题时使用EstimatePassword
 func PasswordLevel(password string) int {
 	/*
-	密码建议
-	1.至少有一个大写字母
-	2.至少有一个小写字母
-	3.至少有一个数字
-	4.长度至少8位
-	5.应该包含特殊字符
+		密码建议
+		1.至少有一个大写字母
+		2.至少有一个小写字母
+		3.至少有一个数字
+		4.长度至少8位
+		5.应该包含特殊字符
 	*/
 	regex, err := regexp.Compile("[A-Z]+")
 	if err != nil {
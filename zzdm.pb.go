@@ -10,6 +10,10 @@
 	It has these top-level messages:
 		Header
 		Frame
+		Metadata
+		XAttr
+		KeySlot
+		Share
 */
 package zzdm
 
@@ -31,9 +35,23 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Header struct {
-	Frames int64  `protobuf:"varint,1,opt,name=frames,proto3" json:"frames,omitempty"`
-	Name   []byte `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Secret bool   `protobuf:"varint,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Frames       int64      `protobuf:"varint,1,opt,name=frames,proto3" json:"frames,omitempty"`
+	Name         []byte     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Secret       bool       `protobuf:"varint,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Cipher       string     `protobuf:"bytes,4,opt,name=cipher,proto3" json:"cipher,omitempty"`
+	Kdf          string     `protobuf:"bytes,5,opt,name=kdf,proto3" json:"kdf,omitempty"`
+	Salt         []byte     `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
+	Cost         int32      `protobuf:"varint,7,opt,name=cost,proto3" json:"cost,omitempty"`
+	FrameSize    int64      `protobuf:"varint,8,opt,name=frame_size,json=frameSize,proto3" json:"frame_size,omitempty"`
+	Meta         []byte     `protobuf:"bytes,9,opt,name=meta,proto3" json:"meta,omitempty"`
+	Naming       string     `protobuf:"bytes,10,opt,name=naming,proto3" json:"naming,omitempty"`
+	Version      int32      `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	Nonce        []byte     `protobuf:"bytes,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Mac          []byte     `protobuf:"bytes,13,opt,name=mac,proto3" json:"mac,omitempty"`
+	ParityData   int32      `protobuf:"varint,14,opt,name=parity_data,json=parityData,proto3" json:"parity_data,omitempty"`
+	ParityShards int32      `protobuf:"varint,15,opt,name=parity_shards,json=parityShards,proto3" json:"parity_shards,omitempty"`
+	Slots        []*KeySlot `protobuf:"bytes,16,rep,name=slots" json:"slots,omitempty"`
+	KeyTime      int64      `protobuf:"varint,17,opt,name=key_time,json=keyTime,proto3" json:"key_time,omitempty"`
 }
 
 func (m *Header) Reset()                    { *m = Header{} }
@@ -62,10 +80,112 @@ func (m *Header) GetSecret() bool {
 	return false
 }
 
+func (m *Header) GetCipher() string {
+	if m != nil {
+		return m.Cipher
+	}
+	return ""
+}
+
+func (m *Header) GetKdf() string {
+	if m != nil {
+		return m.Kdf
+	}
+	return ""
+}
+
+func (m *Header) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *Header) GetCost() int32 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+func (m *Header) GetFrameSize() int64 {
+	if m != nil {
+		return m.FrameSize
+	}
+	return 0
+}
+
+func (m *Header) GetMeta() []byte {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *Header) GetNaming() string {
+	if m != nil {
+		return m.Naming
+	}
+	return ""
+}
+
+func (m *Header) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Header) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *Header) GetMac() []byte {
+	if m != nil {
+		return m.Mac
+	}
+	return nil
+}
+
+func (m *Header) GetParityData() int32 {
+	if m != nil {
+		return m.ParityData
+	}
+	return 0
+}
+
+func (m *Header) GetParityShards() int32 {
+	if m != nil {
+		return m.ParityShards
+	}
+	return 0
+}
+
+func (m *Header) GetSlots() []*KeySlot {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+func (m *Header) GetKeyTime() int64 {
+	if m != nil {
+		return m.KeyTime
+	}
+	return 0
+}
+
 type Frame struct {
-	Iv   []byte `protobuf:"bytes,1,opt,name=iv,proto3" json:"iv,omitempty"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Hash uint32 `protobuf:"varint,3,opt,name=stringBytes,proto3" json:"stringBytes,omitempty"`
+	Iv        []byte `protobuf:"bytes,1,opt,name=iv,proto3" json:"iv,omitempty"`
+	Data      []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Hash      uint32 `protobuf:"varint,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Mac       []byte `protobuf:"bytes,4,opt,name=mac,proto3" json:"mac,omitempty"`
+	Parity    []byte `protobuf:"bytes,5,opt,name=parity,proto3" json:"parity,omitempty"`
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signer    []byte `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
 func (m *Frame) Reset()                    { *m = Frame{} }
@@ -94,9 +214,265 @@ func (m *Frame) GetHash() uint32 {
 	return 0
 }
 
+func (m *Frame) GetMac() []byte {
+	if m != nil {
+		return m.Mac
+	}
+	return nil
+}
+
+func (m *Frame) GetParity() []byte {
+	if m != nil {
+		return m.Parity
+	}
+	return nil
+}
+
+func (m *Frame) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *Frame) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+type Metadata struct {
+	Length        int64    `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Padding       int64    `protobuf:"varint,2,opt,name=padding,proto3" json:"padding,omitempty"`
+	Mode          uint32   `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Mtime         int64    `protobuf:"varint,4,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	Atime         int64    `protobuf:"varint,5,opt,name=atime,proto3" json:"atime,omitempty"`
+	Owner         bool     `protobuf:"varint,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Uid           uint32   `protobuf:"varint,7,opt,name=uid,proto3" json:"uid,omitempty"`
+	Gid           uint32   `protobuf:"varint,8,opt,name=gid,proto3" json:"gid,omitempty"`
+	Xattrs        []*XAttr `protobuf:"bytes,9,rep,name=xattrs" json:"xattrs,omitempty"`
+	PaddingPolicy string   `protobuf:"bytes,10,opt,name=padding_policy,json=paddingPolicy,proto3" json:"padding_policy,omitempty"`
+	PaddingBucket int64    `protobuf:"varint,11,opt,name=padding_bucket,json=paddingBucket,proto3" json:"padding_bucket,omitempty"`
+}
+
+func (m *Metadata) Reset()                    { *m = Metadata{} }
+func (m *Metadata) String() string            { return proto.CompactTextString(m) }
+func (*Metadata) ProtoMessage()               {}
+func (*Metadata) Descriptor() ([]byte, []int) { return fileDescriptorZzdm, []int{2} }
+
+func (m *Metadata) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *Metadata) GetPadding() int64 {
+	if m != nil {
+		return m.Padding
+	}
+	return 0
+}
+
+func (m *Metadata) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *Metadata) GetMtime() int64 {
+	if m != nil {
+		return m.Mtime
+	}
+	return 0
+}
+
+func (m *Metadata) GetAtime() int64 {
+	if m != nil {
+		return m.Atime
+	}
+	return 0
+}
+
+func (m *Metadata) GetOwner() bool {
+	if m != nil {
+		return m.Owner
+	}
+	return false
+}
+
+func (m *Metadata) GetUid() uint32 {
+	if m != nil {
+		return m.Uid
+	}
+	return 0
+}
+
+func (m *Metadata) GetGid() uint32 {
+	if m != nil {
+		return m.Gid
+	}
+	return 0
+}
+
+func (m *Metadata) GetXattrs() []*XAttr {
+	if m != nil {
+		return m.Xattrs
+	}
+	return nil
+}
+
+func (m *Metadata) GetPaddingPolicy() string {
+	if m != nil {
+		return m.PaddingPolicy
+	}
+	return ""
+}
+
+func (m *Metadata) GetPaddingBucket() int64 {
+	if m != nil {
+		return m.PaddingBucket
+	}
+	return 0
+}
+
+type XAttr struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *XAttr) Reset()                    { *m = XAttr{} }
+func (m *XAttr) String() string            { return proto.CompactTextString(m) }
+func (*XAttr) ProtoMessage()               {}
+func (*XAttr) Descriptor() ([]byte, []int) { return fileDescriptorZzdm, []int{3} }
+
+func (m *XAttr) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *XAttr) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type KeySlot struct {
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Kdf       string `protobuf:"bytes,2,opt,name=kdf,proto3" json:"kdf,omitempty"`
+	Salt      []byte `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	Cost      int32  `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Key       []byte `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	Threshold int32  `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Shares    int32  `protobuf:"varint,7,opt,name=shares,proto3" json:"shares,omitempty"`
+	Id        []byte `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *KeySlot) Reset()                    { *m = KeySlot{} }
+func (m *KeySlot) String() string            { return proto.CompactTextString(m) }
+func (*KeySlot) ProtoMessage()               {}
+func (*KeySlot) Descriptor() ([]byte, []int) { return fileDescriptorZzdm, []int{4} }
+
+func (m *KeySlot) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *KeySlot) GetKdf() string {
+	if m != nil {
+		return m.Kdf
+	}
+	return ""
+}
+
+func (m *KeySlot) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *KeySlot) GetCost() int32 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+func (m *KeySlot) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *KeySlot) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *KeySlot) GetShares() int32 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+func (m *KeySlot) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+type Share struct {
+	Id        []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Threshold int32  `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Value     []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Share) Reset()                    { *m = Share{} }
+func (m *Share) String() string            { return proto.CompactTextString(m) }
+func (*Share) ProtoMessage()               {}
+func (*Share) Descriptor() ([]byte, []int) { return fileDescriptorZzdm, []int{5} }
+
+func (m *Share) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Share) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Share) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Header)(nil), "zzdm.Header")
 	proto.RegisterType((*Frame)(nil), "zzdm.Frame")
+	proto.RegisterType((*Metadata)(nil), "zzdm.Metadata")
+	proto.RegisterType((*XAttr)(nil), "zzdm.XAttr")
+	proto.RegisterType((*KeySlot)(nil), "zzdm.KeySlot")
+	proto.RegisterType((*Share)(nil), "zzdm.Share")
 }
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -134,6 +510,94 @@ func (m *Header) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Cipher) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Cipher)))
+		i += copy(dAtA[i:], m.Cipher)
+	}
+	if len(m.Kdf) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Kdf)))
+		i += copy(dAtA[i:], m.Kdf)
+	}
+	if len(m.Salt) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Salt)))
+		i += copy(dAtA[i:], m.Salt)
+	}
+	if m.Cost != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Cost))
+	}
+	if m.FrameSize != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.FrameSize))
+	}
+	if len(m.Meta) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Meta)))
+		i += copy(dAtA[i:], m.Meta)
+	}
+	if len(m.Naming) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Naming)))
+		i += copy(dAtA[i:], m.Naming)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Version))
+	}
+	if len(m.Nonce) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Nonce)))
+		i += copy(dAtA[i:], m.Nonce)
+	}
+	if len(m.Mac) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Mac)))
+		i += copy(dAtA[i:], m.Mac)
+	}
+	if m.ParityData != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.ParityData))
+	}
+	if m.ParityShards != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.ParityShards))
+	}
+	if len(m.Slots) > 0 {
+		for _, msg := range m.Slots {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintZzdm(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.KeyTime != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.KeyTime))
+	}
 	return i, nil
 }
 
@@ -169,10 +633,248 @@ func (m *Frame) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintZzdm(dAtA, i, uint64(m.Hash))
 	}
+	if len(m.Mac) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Mac)))
+		i += copy(dAtA[i:], m.Mac)
+	}
+	if len(m.Parity) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Parity)))
+		i += copy(dAtA[i:], m.Parity)
+	}
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	if len(m.Signer) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Signer)))
+		i += copy(dAtA[i:], m.Signer)
+	}
 	return i, nil
 }
 
-func encodeVarintZzdm(dAtA []byte, offset int, v uint64) int {
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Length))
+	}
+	if m.Padding != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Padding))
+	}
+	if m.Mode != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Mode))
+	}
+	if m.Mtime != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Mtime))
+	}
+	if m.Atime != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Atime))
+	}
+	if m.Owner {
+		dAtA[i] = 0x30
+		i++
+		if m.Owner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Uid != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Uid))
+	}
+	if m.Gid != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Gid))
+	}
+	if len(m.Xattrs) > 0 {
+		for _, msg := range m.Xattrs {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintZzdm(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.PaddingPolicy) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.PaddingPolicy)))
+		i += copy(dAtA[i:], m.PaddingPolicy)
+	}
+	if m.PaddingBucket != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.PaddingBucket))
+	}
+	return i, nil
+}
+
+func (m *XAttr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *XAttr) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *KeySlot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeySlot) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Kdf) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Kdf)))
+		i += copy(dAtA[i:], m.Kdf)
+	}
+	if len(m.Salt) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Salt)))
+		i += copy(dAtA[i:], m.Salt)
+	}
+	if m.Cost != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Cost))
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Threshold != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Threshold))
+	}
+	if m.Shares != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Shares))
+	}
+	if len(m.Id) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *Share) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Share) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Threshold != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(m.Threshold))
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintZzdm(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func encodeVarintZzdm(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -194,6 +896,58 @@ func (m *Header) Size() (n int) {
 	if m.Secret {
 		n += 2
 	}
+	l = len(m.Cipher)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	l = len(m.Kdf)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	if m.Cost != 0 {
+		n += 1 + sovZzdm(uint64(m.Cost))
+	}
+	if m.FrameSize != 0 {
+		n += 1 + sovZzdm(uint64(m.FrameSize))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	l = len(m.Naming)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovZzdm(uint64(m.Version))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	l = len(m.Mac)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	if m.ParityData != 0 {
+		n += 1 + sovZzdm(uint64(m.ParityData))
+	}
+	if m.ParityShards != 0 {
+		n += 1 + sovZzdm(uint64(m.ParityShards))
+	}
+	if len(m.Slots) > 0 {
+		for _, e := range m.Slots {
+			l = e.Size()
+			n += 2 + l + sovZzdm(uint64(l))
+		}
+	}
+	if m.KeyTime != 0 {
+		n += 2 + sovZzdm(uint64(m.KeyTime))
+	}
 	return n
 }
 
@@ -211,23 +965,1271 @@ func (m *Frame) Size() (n int) {
 	if m.Hash != 0 {
 		n += 1 + sovZzdm(uint64(m.Hash))
 	}
-	return n
-}
+	l = len(m.Mac)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	l = len(m.Parity)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovZzdm(uint64(m.Length))
+	}
+	if m.Padding != 0 {
+		n += 1 + sovZzdm(uint64(m.Padding))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovZzdm(uint64(m.Mode))
+	}
+	if m.Mtime != 0 {
+		n += 1 + sovZzdm(uint64(m.Mtime))
+	}
+	if m.Atime != 0 {
+		n += 1 + sovZzdm(uint64(m.Atime))
+	}
+	if m.Owner {
+		n += 2
+	}
+	if m.Uid != 0 {
+		n += 1 + sovZzdm(uint64(m.Uid))
+	}
+	if m.Gid != 0 {
+		n += 1 + sovZzdm(uint64(m.Gid))
+	}
+	if len(m.Xattrs) > 0 {
+		for _, e := range m.Xattrs {
+			l = e.Size()
+			n += 1 + l + sovZzdm(uint64(l))
+		}
+	}
+	l = len(m.PaddingPolicy)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	if m.PaddingBucket != 0 {
+		n += 1 + sovZzdm(uint64(m.PaddingBucket))
+	}
+	return n
+}
+
+func (m *XAttr) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	return n
+}
+
+func (m *KeySlot) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	l = len(m.Kdf)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	if m.Cost != 0 {
+		n += 1 + sovZzdm(uint64(m.Cost))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovZzdm(uint64(m.Threshold))
+	}
+	if m.Shares != 0 {
+		n += 1 + sovZzdm(uint64(m.Shares))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	return n
+}
+
+func (m *Share) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovZzdm(uint64(m.Threshold))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovZzdm(uint64(l))
+	}
+	return n
+}
+
+func sovZzdm(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozZzdm(x uint64) (n int) {
+	return sovZzdm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZzdm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+			}
+			m.Frames = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frames |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Secret = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cipher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cipher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kdf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kdf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			m.Cost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cost |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameSize", wireType)
+			}
+			m.FrameSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrameSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = append(m.Meta[:0], dAtA[iNdEx:postIndex]...)
+			if m.Meta == nil {
+				m.Meta = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Naming", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Naming = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.Nonce == nil {
+				m.Nonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mac", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mac = append(m.Mac[:0], dAtA[iNdEx:postIndex]...)
+			if m.Mac == nil {
+				m.Mac = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParityData", wireType)
+			}
+			m.ParityData = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParityData |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParityShards", wireType)
+			}
+			m.ParityShards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParityShards |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slots = append(m.Slots, &KeySlot{})
+			if err := m.Slots[len(m.Slots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyTime", wireType)
+			}
+			m.KeyTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZzdm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Frame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZzdm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Frame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Frame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iv", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iv = append(m.Iv[:0], dAtA[iNdEx:postIndex]...)
+			if m.Iv == nil {
+				m.Iv = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			m.Hash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hash |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mac", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mac = append(m.Mac[:0], dAtA[iNdEx:postIndex]...)
+			if m.Mac == nil {
+				m.Mac = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parity = append(m.Parity[:0], dAtA[iNdEx:postIndex]...)
+			if m.Parity == nil {
+				m.Parity = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZzdm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZzdm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Padding", wireType)
+			}
+			m.Padding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Padding |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtime", wireType)
+			}
+			m.Mtime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mtime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atime", wireType)
+			}
+			m.Atime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Atime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Owner = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uid |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+			}
+			m.Gid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gid |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xattrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Xattrs = append(m.Xattrs, &XAttr{})
+			if err := m.Xattrs[len(m.Xattrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaddingPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaddingPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaddingBucket", wireType)
+			}
+			m.PaddingBucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaddingBucket |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZzdm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *XAttr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowZzdm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: XAttr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: XAttr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipZzdm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovZzdm(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozZzdm(x uint64) (n int) {
-	return sovZzdm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *KeySlot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -250,17 +2252,17 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeySlot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeySlot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Frames = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZzdm
@@ -270,14 +2272,53 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frames |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kdf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kdf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -301,16 +2342,16 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
-			if m.Name == nil {
-				m.Name = []byte{}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
-			var v int
+			m.Cost = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZzdm
@@ -320,12 +2361,111 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Cost |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Secret = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			m.Shares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shares |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowZzdm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipZzdm(dAtA[iNdEx:])
@@ -347,7 +2487,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Frame) Unmarshal(dAtA []byte) error {
+func (m *Share) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -370,15 +2510,15 @@ func (m *Frame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Frame: wiretype end group for non-group")
+			return fmt.Errorf("proto: Share: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Frame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Share: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Iv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -402,16 +2542,16 @@ func (m *Frame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Iv = append(m.Iv[:0], dAtA[iNdEx:postIndex]...)
-			if m.Iv == nil {
-				m.Iv = []byte{}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var byteLen int
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZzdm
@@ -421,28 +2561,16 @@ func (m *Frame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Threshold |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthZzdm
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Hash = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowZzdm
@@ -452,11 +2580,23 @@ func (m *Frame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Hash |= (uint32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthZzdm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipZzdm(dAtA[iNdEx:])
@@ -586,16 +2726,47 @@ var (
 func init() { proto.RegisterFile("zzdm.proto", fileDescriptorZzdm) }
 
 var fileDescriptorZzdm = []byte{
-	// 170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xaa, 0xaa, 0x4a, 0xc9,
-	0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x01, 0xb1, 0x95, 0x7c, 0xb8, 0xd8, 0x3c, 0x52,
-	0x13, 0x53, 0x52, 0x8b, 0x84, 0xc4, 0xb8, 0xd8, 0xd2, 0x8a, 0x12, 0x73, 0x53, 0x8b, 0x25, 0x18,
-	0x15, 0x18, 0x35, 0x98, 0x83, 0xa0, 0x3c, 0x21, 0x21, 0x2e, 0x96, 0xbc, 0xc4, 0xdc, 0x54, 0x09,
-	0x26, 0x05, 0x46, 0x0d, 0x9e, 0x20, 0x30, 0x1b, 0xa4, 0xb6, 0x38, 0x35, 0xb9, 0x28, 0xb5, 0x44,
-	0x82, 0x59, 0x81, 0x51, 0x83, 0x23, 0x08, 0xca, 0x53, 0xb2, 0xe7, 0x62, 0x75, 0x03, 0xe9, 0x12,
-	0xe2, 0xe3, 0x62, 0xca, 0x2c, 0x03, 0x1b, 0xc4, 0x13, 0xc4, 0x94, 0x59, 0x06, 0x32, 0x24, 0x25,
-	0xb1, 0x24, 0x11, 0x66, 0x08, 0x88, 0x0d, 0x12, 0xcb, 0x48, 0x2c, 0xce, 0x00, 0x1b, 0xc1, 0x1b,
-	0x04, 0x66, 0x3b, 0x09, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x33, 0x1e, 0xcb, 0x31, 0x78, 0x30, 0x26, 0xb1, 0x81, 0xdd, 0x6b, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x7f, 0xc7, 0xa0, 0x3d, 0xbd, 0x00, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcd, 0x6e, 0x13, 0x3b,
+	0x14, 0xbe, 0x93, 0xc9, 0x4c, 0x12, 0x27, 0xe9, 0xed, 0xb5, 0xae, 0x2a, 0x5f, 0xe9, 0x12, 0xa2,
+	0x54, 0x48, 0x59, 0x55, 0x02, 0x9e, 0x80, 0x0a, 0xa1, 0x4a, 0x15, 0x12, 0x72, 0x59, 0xb0, 0x8b,
+	0xdc, 0x99, 0xd3, 0x8c, 0x95, 0xf9, 0x89, 0x6c, 0x27, 0x90, 0x3c, 0x09, 0x3b, 0xd6, 0xac, 0x10,
+	0x6f, 0xc1, 0x92, 0x47, 0x40, 0xe5, 0x45, 0xd0, 0x39, 0xf6, 0xa4, 0xe5, 0x67, 0xf7, 0x7d, 0xdf,
+	0xd8, 0x27, 0xe7, 0xf8, 0xfb, 0x4e, 0x18, 0xdb, 0xef, 0xf3, 0xea, 0x6c, 0x6d, 0x1a, 0xd7, 0xf0,
+	0x2e, 0xe2, 0xd9, 0xe7, 0x98, 0xa5, 0x17, 0xa0, 0x72, 0x30, 0xfc, 0x84, 0xa5, 0x37, 0x46, 0x55,
+	0x60, 0x45, 0x34, 0x8d, 0xe6, 0xb1, 0x0c, 0x8c, 0x73, 0xd6, 0xad, 0x55, 0x05, 0xa2, 0x33, 0x8d,
+	0xe6, 0x23, 0x49, 0x18, 0xcf, 0x5a, 0xc8, 0x0c, 0x38, 0x11, 0x4f, 0xa3, 0x79, 0x5f, 0x06, 0x86,
+	0x7a, 0xa6, 0xd7, 0x05, 0x18, 0xd1, 0x9d, 0x46, 0xf3, 0x81, 0x0c, 0x8c, 0x1f, 0xb3, 0x78, 0x95,
+	0xdf, 0x88, 0x84, 0x44, 0x84, 0x58, 0xd5, 0xaa, 0xd2, 0x89, 0xd4, 0x57, 0x45, 0x8c, 0x5a, 0xd6,
+	0x58, 0x27, 0x7a, 0xd3, 0x68, 0x9e, 0x48, 0xc2, 0xfc, 0x01, 0x63, 0xd4, 0xc7, 0xc2, 0xea, 0x3d,
+	0x88, 0x3e, 0x75, 0x36, 0x20, 0xe5, 0x4a, 0xef, 0x01, 0xaf, 0x54, 0xe0, 0x94, 0x18, 0xf8, 0x32,
+	0x88, 0xb1, 0x89, 0x5a, 0x55, 0xba, 0x5e, 0x0a, 0xe6, 0x9b, 0xf0, 0x8c, 0x0b, 0xd6, 0xdb, 0x82,
+	0xb1, 0xba, 0xa9, 0xc5, 0x90, 0x7e, 0xa1, 0xa5, 0xfc, 0x5f, 0x96, 0xd4, 0x4d, 0x9d, 0x81, 0x18,
+	0x51, 0x19, 0x4f, 0xb0, 0xe9, 0x4a, 0x65, 0x62, 0x4c, 0x1a, 0x42, 0xfe, 0x90, 0x0d, 0xd7, 0xca,
+	0x68, 0xb7, 0x5b, 0xe4, 0xca, 0x29, 0x71, 0x44, 0x55, 0x98, 0x97, 0x9e, 0x2b, 0xa7, 0xf8, 0x29,
+	0x1b, 0x87, 0x03, 0xb6, 0x50, 0x26, 0xb7, 0xe2, 0x6f, 0x3a, 0x32, 0xf2, 0xe2, 0x15, 0x69, 0xfc,
+	0x94, 0x25, 0xb6, 0x6c, 0x9c, 0x15, 0xc7, 0xd3, 0x78, 0x3e, 0x7c, 0x32, 0x3e, 0x23, 0x57, 0x2e,
+	0x61, 0x77, 0x55, 0x36, 0x4e, 0xfa, 0x6f, 0xfc, 0x3f, 0xd6, 0x5f, 0xc1, 0x6e, 0xe1, 0x74, 0x05,
+	0xe2, 0x1f, 0x9a, 0xba, 0xb7, 0x82, 0xdd, 0x6b, 0x5d, 0xc1, 0xec, 0x43, 0xc4, 0x92, 0x17, 0xf8,
+	0x02, 0xfc, 0x88, 0x75, 0xf4, 0x96, 0xec, 0x1a, 0xc9, 0x8e, 0xde, 0xe2, 0x6b, 0x50, 0x63, 0xc1,
+	0x2a, 0xc4, 0xa8, 0x15, 0xca, 0x16, 0x64, 0xd4, 0x58, 0x12, 0x6e, 0x27, 0xeb, 0xde, 0x4d, 0x76,
+	0xc2, 0x52, 0xdf, 0x23, 0x79, 0x34, 0x92, 0x81, 0xf1, 0xff, 0xd9, 0xc0, 0xea, 0x65, 0xad, 0xdc,
+	0xc6, 0x40, 0xf0, 0xea, 0x4e, 0xa0, 0x18, 0xe8, 0x65, 0x0d, 0x86, 0x2c, 0x1b, 0xc9, 0xc0, 0x66,
+	0x1f, 0x3b, 0xac, 0xff, 0x12, 0x9c, 0xa2, 0x06, 0x4e, 0x58, 0x5a, 0x42, 0xbd, 0x74, 0x45, 0x9b,
+	0x2b, 0xcf, 0xd0, 0x8e, 0xb5, 0xca, 0x73, 0xf4, 0xa9, 0xe3, 0x07, 0x0c, 0x94, 0x4c, 0x6d, 0x72,
+	0x68, 0x5b, 0x46, 0x8c, 0x16, 0x55, 0xf4, 0x18, 0x5d, 0x3a, 0xeb, 0x09, 0xaa, 0x8a, 0xd4, 0xc4,
+	0xab, 0xaa, 0x55, 0x9b, 0xb7, 0xd8, 0x55, 0x4a, 0xe1, 0xf4, 0x04, 0x87, 0xde, 0xe8, 0x9c, 0x3a,
+	0x1d, 0x4b, 0x84, 0xa8, 0x2c, 0x75, 0x4e, 0xa1, 0x1a, 0x4b, 0x84, 0xfc, 0x94, 0xa5, 0xef, 0x94,
+	0x73, 0xc6, 0x8a, 0x01, 0x79, 0x33, 0xf4, 0xde, 0xbc, 0x79, 0xe6, 0x9c, 0x91, 0xe1, 0x13, 0x7f,
+	0xc4, 0x8e, 0x42, 0xa7, 0x8b, 0x75, 0x53, 0xea, 0x6c, 0x17, 0x72, 0x36, 0x0e, 0xea, 0x2b, 0x12,
+	0xef, 0x1f, 0xbb, 0xde, 0x64, 0x2b, 0x70, 0x94, 0xba, 0xf8, 0x70, 0xec, 0x9c, 0xc4, 0xd9, 0x63,
+	0x96, 0x50, 0xf9, 0xc3, 0x9e, 0x45, 0x54, 0x8c, 0x30, 0x4e, 0xb2, 0x55, 0xe5, 0xa6, 0x5d, 0x3e,
+	0x4f, 0x66, 0x9f, 0x22, 0xd6, 0x0b, 0x71, 0xc1, 0x5b, 0x6e, 0xb7, 0x3e, 0xdc, 0x42, 0xdc, 0x6e,
+	0x5b, 0xe7, 0xf7, 0x6d, 0x8b, 0xff, 0xb0, 0x6d, 0xdd, 0x7b, 0xdb, 0x86, 0x37, 0xa1, 0xcd, 0x00,
+	0x42, 0x0c, 0x80, 0x2b, 0x0c, 0xd8, 0xa2, 0x29, 0x73, 0x7a, 0xcf, 0x44, 0xde, 0x09, 0x14, 0x80,
+	0x42, 0x19, 0xb0, 0x61, 0x67, 0x03, 0xa3, 0x60, 0xfa, 0x87, 0xc5, 0x60, 0xe6, 0xb3, 0x4b, 0x96,
+	0x60, 0xf8, 0x21, 0x7c, 0x68, 0x13, 0x9b, 0xff, 0x5c, 0xbe, 0xf3, 0x6b, 0xf9, 0xc3, 0xf8, 0xf1,
+	0xbd, 0xf1, 0xcf, 0xf9, 0x45, 0xf4, 0xe5, 0x76, 0x12, 0x7d, 0xbd, 0x9d, 0x44, 0xdf, 0x6e, 0x27,
+	0xd1, 0xfb, 0xef, 0x93, 0xbf, 0xae, 0x53, 0xfa, 0x53, 0x7b, 0xfa, 0x63, 0x00, 0xb4, 0x5d, 0xa7,
+	0x70, 0xe2, 0x04, 0x00, 0x00,
 }